@@ -0,0 +1,31 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+data "timeconv_blackout" "freeze" {
+  location = "Asia/Tokyo"
+  enforce  = false
+
+  windows = [
+    {
+      name  = "year-end"
+      start = "2024-12-28T00:00:00"
+      end   = "2025-01-04T00:00:00"
+    },
+    {
+      name     = "weekend"
+      schedule = "0 22 ? * FRI *"
+      duration = "2d"
+    },
+  ]
+}
+
+resource "terraform_data" "deploy" {
+  input = var.release_version
+
+  lifecycle {
+    precondition {
+      condition     = !data.timeconv_blackout.freeze.active
+      error_message = "Change freeze: ${coalesce(data.timeconv_blackout.freeze.active_window, "")} until ${coalesce(data.timeconv_blackout.freeze.active_until, "")}."
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+locals {
+  # A fixed reference keeps later plans valid after start_time has passed.
+  schedule = provider::timeconv::aws_autoscaling_schedule("0 9 ? * MON-FRI *", "Asia/Tokyo", "2030-01-01T00:00:00+09:00", "2030-02-01T00:00:00+09:00", "2026-10-18T00:00:00Z")
+}
+
+resource "aws_autoscaling_schedule" "business_hours" {
+  scheduled_action_name  = "business-hours"
+  autoscaling_group_name = "example"
+  min_size               = 2
+  max_size               = 4
+  desired_capacity       = 2
+  recurrence             = local.schedule.recurrence # "0 9 * * 1-5"
+  time_zone              = local.schedule.time_zone  # "Asia/Tokyo"
+  start_time             = local.schedule.start_time # "2029-12-31T15:00:00Z"
+  end_time               = local.schedule.end_time   # "2030-01-31T15:00:00Z"
+}
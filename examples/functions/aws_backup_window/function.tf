@@ -0,0 +1,6 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "preferred_backup_window" {
+  value = provider::timeconv::aws_backup_window("03:00", "30m", "Asia/Tokyo", plantimestamp()) # "18:00-18:30"
+}
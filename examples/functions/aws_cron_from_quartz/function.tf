@@ -0,0 +1,6 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "sample" {
+  value = provider::timeconv::aws_cron_from_quartz("0 15 10 ? * 6L 2026-2028") # "15 10 ? * FRIL 2026-2028"
+}
@@ -0,0 +1,6 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "sample" {
+  value = provider::timeconv::aws_cron_from_spring("0 0 12 * * 1-5") # "0 12 ? * MON-FRI *"
+}
@@ -0,0 +1,6 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "preferred_maintenance_window" {
+  value = provider::timeconv::aws_maintenance_window("Sun", "03:00", "1h", "Asia/Tokyo", plantimestamp()) # "sat:18:00-sat:19:00"
+}
@@ -0,0 +1,14 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+locals {
+  schedule = provider::timeconv::aws_schedule_expression("2024-08-31T01:23:45Z", "Asia/Tokyo")
+}
+
+output "schedule_expression" {
+  value = local.schedule.expression # "at(2024-08-31T10:23:45)"
+}
+
+output "schedule_expression_timezone" {
+  value = local.schedule.timezone # "Asia/Tokyo"
+}
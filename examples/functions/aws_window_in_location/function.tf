@@ -0,0 +1,7 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "maintenance_window_in_tokyo" {
+  # { day = "Sun", start = "08:30", duration = "1h", window = "sun:08:30-sun:09:30" }
+  value = provider::timeconv::aws_window_in_location("sat:23:30-sun:00:30", "Asia/Tokyo", plantimestamp())
+}
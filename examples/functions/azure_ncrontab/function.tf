@@ -0,0 +1,6 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "sample" {
+  value = provider::timeconv::azure_ncrontab("30 2 1,15 * ? *") # "0 30 2 1,15 * *"
+}
@@ -0,0 +1,22 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "persian" {
+  value = provider::timeconv::calendar_convert("2026-10-18T09:00:00+03:30", "persian").formatted # "26 Mehr 1405"
+}
+
+output "hebrew" {
+  value = provider::timeconv::calendar_convert("2026-09-12T00:00:00+03:00", "hebrew").formatted # "1 Tishrei 5787"
+}
+
+output "islamic_umalqura" {
+  value = provider::timeconv::calendar_convert("2026-10-18T00:00:00+03:00", "islamic_umalqura").formatted # "7 Jumada al-Awwal 1448"
+}
+
+output "thai_buddhist_year" {
+  value = provider::timeconv::calendar_convert("2026-10-18T00:00:00+07:00", "thai_buddhist").year # 2569
+}
+
+output "iso_week" {
+  value = provider::timeconv::calendar_convert("2026-10-18T00:00:00Z", "iso_week").date # "2026-W42-7"
+}
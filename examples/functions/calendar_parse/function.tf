@@ -0,0 +1,14 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "nowruz" {
+  value = provider::timeconv::calendar_parse("1405-01-01", "persian", "Asia/Tehran") # "2026-03-21T00:00:00+03:30"
+}
+
+output "ramadan" {
+  value = provider::timeconv::calendar_parse("1447-09-01", "islamic_civil", "Asia/Riyadh") # "2026-02-18T00:00:00+03:00"
+}
+
+output "iso_week" {
+  value = provider::timeconv::calendar_parse("2026-W42-1", "iso_week", null) # "2026-10-12T00:00:00Z"
+}
@@ -0,0 +1,14 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+locals {
+  weekly = provider::timeconv::cron_from_time("2024-03-15T07:30:00Z", "weekly", "Asia/Tokyo")
+}
+
+output "aws_cron" {
+  value = local.weekly.aws_cron # "30 16 ? * FRI *"
+}
+
+output "unix_cron" {
+  value = local.weekly.unix_cron # "30 16 * * 5"
+}
@@ -0,0 +1,16 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+variable "schedule" {
+  type    = string
+  default = "0 12 * * ? *"
+
+  validation {
+    condition     = length(provider::timeconv::cron_lint(var.schedule, "America/New_York", plantimestamp())) == 0
+    error_message = "The schedule is suspicious in America/New_York."
+  }
+}
+
+output "warnings" {
+  value = provider::timeconv::cron_lint("30 2 31 * ? *", null, "2026-10-18T00:00:00Z") # ["day-of-month 31 is skipped in Feb, Apr, Jun, Sep, Nov"]
+}
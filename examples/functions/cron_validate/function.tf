@@ -0,0 +1,29 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+variable "backup_schedule" {
+  type    = string
+  default = "cron(0 5 ? * * *)"
+
+  validation {
+    condition     = can(provider::timeconv::cron_validate(var.backup_schedule, "backup", null))
+    error_message = "The schedule is not accepted by AWS Backup."
+  }
+}
+
+output "recurrence" {
+  value = provider::timeconv::cron_validate("0 9 ? * MON-FRI *", "autoscaling", null) # "0 9 * * 1-5"
+}
+
+variable "patch_offset" {
+  type    = number
+  default = 2
+}
+
+resource "aws_ssm_maintenance_window" "patch" {
+  name            = "patch"
+  schedule        = provider::timeconv::cron_validate("cron(30 23 ? * TUE#2 *)", "ssm", var.patch_offset) # "cron(30 23 ? * TUE#2 *)"
+  schedule_offset = var.patch_offset
+  duration        = 3
+  cutoff          = 1
+}
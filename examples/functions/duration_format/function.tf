@@ -0,0 +1,22 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+locals {
+  interval = provider::timeconv::duration_parse("90m", "prometheus") # 5400
+}
+
+output "alarm_period" {
+  value = provider::timeconv::duration_format(local.interval, "cloudwatch") # "5400"
+}
+
+output "scrape_interval" {
+  value = provider::timeconv::duration_format(local.interval, "prometheus") # "1h30m"
+}
+
+output "schedule_expression" {
+  value = provider::timeconv::duration_format(local.interval, "aws_rate") # "rate(90 minutes)"
+}
+
+output "timer_interval" {
+  value = provider::timeconv::duration_format(local.interval, "systemd") # "1h 30min"
+}
@@ -0,0 +1,19 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+variable "interval" {
+  type    = string
+  default = "PT1H30M"
+}
+
+output "seconds" {
+  value = provider::timeconv::duration_parse(var.interval, "iso8601") # 5400
+}
+
+output "from_systemd" {
+  value = provider::timeconv::duration_parse("1h 30min", "systemd") # 5400
+}
+
+output "from_aws_rate" {
+  value = provider::timeconv::duration_parse("rate(90 minutes)", "aws_rate") # 5400
+}
@@ -0,0 +1,19 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "every_6_hours" {
+  value = provider::timeconv::expand_repeating_interval("R3/2026-01-01T00:00Z/PT6H", null, null)
+  # [
+  #   { start = "2026-01-01T00:00:00Z", end = "2026-01-01T06:00:00Z", duration = "PT6H" },
+  #   { start = "2026-01-01T06:00:00Z", end = "2026-01-01T12:00:00Z", duration = "PT6H" },
+  #   { start = "2026-01-01T12:00:00Z", end = "2026-01-01T18:00:00Z", duration = "PT6H" },
+  # ]
+}
+
+output "monthly_unbounded" {
+  value = provider::timeconv::expand_repeating_interval("R/2026-01-31T00:00Z/P1M", null, 2)
+  # [
+  #   { start = "2026-01-31T00:00:00Z", end = "2026-02-28T00:00:00Z", duration = "P1M" },
+  #   { start = "2026-02-28T00:00:00Z", end = "2026-03-31T00:00:00Z", duration = "P1M" },
+  # ]
+}
@@ -4,3 +4,11 @@
 output "sample" {
   value = provider::timeconv::format("2024-08-31T01:23:45+0900", "YYYY-MM-DD HH:mm:ss")
 }
+
+output "japanese" {
+  value = provider::timeconv::format("2024-08-31T13:23:45+09:00", "2006年1月2日(Mon) PM3時04分", "ja-JP") # "2024年8月31日(土) 午後1時23分"
+}
+
+output "german" {
+  value = provider::timeconv::format("2024-08-31T13:23:45+09:00", "Monday, 2. January 2006", "de-DE") # "Samstag, 31. August 2024"
+}
@@ -0,0 +1,10 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "from_kanji" {
+  value = provider::timeconv::from_japanese_era("令和8年10月18日", "Asia/Tokyo") # "2026-10-18T00:00:00+09:00"
+}
+
+output "from_short" {
+  value = provider::timeconv::from_japanese_era("R1.5.1", "Asia/Tokyo") # "2019-05-01T00:00:00+09:00"
+}
@@ -0,0 +1,14 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+locals {
+  schedule = provider::timeconv::gcp_schedule("0/15 9-17 ? * MON-FRI *", "Asia/Tokyo")
+}
+
+output "schedule" {
+  value = local.schedule.schedule # "*/15 9-17 * * 1-5"
+}
+
+output "time_zone" {
+  value = local.schedule.time_zone # "Asia/Tokyo"
+}
@@ -0,0 +1,10 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "retention" {
+  value = provider::timeconv::humanize_duration(183659, null) # "2 days 3 hours"
+}
+
+output "retention_ja" {
+  value = provider::timeconv::humanize_duration(183659, { locale = "ja-JP", units = 3 }) # "2日3時間"
+}
@@ -0,0 +1,20 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+variable "expires_at" {
+  type    = string
+  default = "2026-01-04T00:00:00Z"
+}
+
+output "expiry_notice" {
+  value = "Expires ${provider::timeconv::humanize_relative(var.expires_at, "2026-01-01T00:00:00Z", null)}" # "Expires in 3 days"
+}
+
+output "expiry_notice_ja" {
+  value = "${provider::timeconv::humanize_relative(var.expires_at, "2026-01-01T00:00:00Z", { locale = "ja" })}に失効します" # "3日後に失効します"
+}
+
+output "since_now" {
+  # Relative to the time of the plan.
+  value = provider::timeconv::humanize_relative("2026-01-01T00:00:00Z", plantimestamp(), null)
+}
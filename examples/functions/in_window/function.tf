@@ -0,0 +1,12 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+variable "scheduled_at" {
+  type    = string
+  default = "2024-07-06T21:00:00Z"
+
+  validation {
+    condition     = provider::timeconv::in_window(var.scheduled_at, "0 22 ? * SAT *", "6h", "Europe/Berlin")
+    error_message = "The scheduled time must be in the approved window(Sat 22:00 for 6h in Europe/Berlin)."
+  }
+}
@@ -0,0 +1,9 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "contained" {
+  value = provider::timeconv::interval_contains(
+    { start = "2024-01-01T00:00:00Z", end = "2025-01-01T00:00:00Z" },
+    { start = "2024-03-01T00:00:00Z", end = "2024-04-01T00:00:00Z" },
+  ) # true
+}
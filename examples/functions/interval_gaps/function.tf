@@ -0,0 +1,12 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "uncovered" {
+  value = provider::timeconv::interval_gaps(
+    [
+      { start = "2024-01-01T00:00:00Z", end = "2024-07-01T00:00:00Z" },
+      { start = "2024-08-01T00:00:00Z", end = "2025-01-01T00:00:00Z" },
+    ],
+    { start = "2024-01-01T00:00:00Z", end = "2025-01-01T00:00:00Z" },
+  ) # [{ start = "2024-07-01T00:00:00Z", end = "2024-08-01T00:00:00Z" }]
+}
@@ -0,0 +1,9 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "intersection" {
+  value = provider::timeconv::interval_intersect(
+    { start = "2024-01-01T00:00:00Z", end = "2024-01-10T00:00:00Z" },
+    { start = "2024-01-05T09:00:00+09:00", end = "2024-01-20T00:00:00Z" },
+  ) # { start = "2024-01-05T09:00:00+09:00", end = "2024-01-10T00:00:00Z" }
+}
@@ -0,0 +1,18 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+variable "maintenance" {
+  type = object({ start = string, end = string })
+  default = {
+    start = "2024-01-05T22:00:00+09:00"
+    end   = "2024-01-06T02:00:00+09:00"
+  }
+
+  validation {
+    condition = !provider::timeconv::interval_overlaps(var.maintenance, {
+      start = "2024-01-05T00:00:00Z"
+      end   = "2024-01-05T12:00:00Z"
+    })
+    error_message = "The maintenance window collides with the campaign."
+  }
+}
@@ -0,0 +1,14 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+locals {
+  schedule = provider::timeconv::kubernetes_schedule("0 12 ? * FRI *", "Europe/Berlin")
+}
+
+output "schedule" {
+  value = local.schedule.schedule # "0 12 * * 5"
+}
+
+output "time_zone" {
+  value = local.schedule.time_zone # "Europe/Berlin"
+}
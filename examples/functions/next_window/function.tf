@@ -0,0 +1,14 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+locals {
+  window = provider::timeconv::next_window("2024-07-08T00:00:00Z", "0 22 ? * SAT *", "6h", "Europe/Berlin")
+}
+
+output "start" {
+  value = local.window.start # "2024-07-13T22:00:00+02:00"
+}
+
+output "end" {
+  value = local.window.end # "2024-07-14T04:00:00+02:00"
+}
@@ -0,0 +1,10 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "rfc3339" {
+  value = provider::timeconv::parse(null, "2026-10-18T09:30:00+09:00") # "2026-10-18T09:30:00+09:00"
+}
+
+output "french" {
+  value = provider::timeconv::parse("2 January 2006", "18 octobre 2026", "fr-FR") # "2026-10-18T00:00:00Z"
+}
@@ -0,0 +1,10 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "japanese" {
+  value = provider::timeconv::parse_in_location("2006年1月2日 15時04分", "2026年10月18日 9時30分", "Asia/Tokyo") # "2026-10-18T09:30:00+09:00"
+}
+
+output "japanese_with_names" {
+  value = provider::timeconv::parse_in_location("2006年1月2日(Mon) PM3時04分", "2026年10月18日(日) 午後9時30分", "Asia/Tokyo", "ja-JP") # "2026-10-18T21:30:00+09:00"
+}
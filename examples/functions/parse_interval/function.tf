@@ -0,0 +1,17 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "start_duration" {
+  value = provider::timeconv::parse_interval("2026-01-01T00:00Z/P1D", null)
+  # { start = "2026-01-01T00:00:00Z", end = "2026-01-02T00:00:00Z", duration = "P1D" }
+}
+
+output "duration_end" {
+  value = provider::timeconv::parse_interval("P1W/2026-02-01T00:00Z", null)
+  # { start = "2026-01-25T00:00:00Z", end = "2026-02-01T00:00:00Z", duration = "P1W" }
+}
+
+output "start_end_in_location" {
+  value = provider::timeconv::parse_interval("2026-01-01T09:00/2026-01-01T18:00", "Asia/Tokyo")
+  # { start = "2026-01-01T09:00:00+09:00", end = "2026-01-01T18:00:00+09:00", duration = "PT9H" }
+}
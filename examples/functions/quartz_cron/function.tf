@@ -0,0 +1,6 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "sample" {
+  value = provider::timeconv::quartz_cron("0 12 ? * 2#1 *") # "0 0 12 ? * MON#1 *"
+}
@@ -0,0 +1,6 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "sample" {
+  value = provider::timeconv::spring_cron("0/15 9-17 ? * MON-FRI *") # "0 0/15 9-17 ? * MON-FRI"
+}
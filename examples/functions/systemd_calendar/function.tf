@@ -0,0 +1,10 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "from_aws_cron" {
+  value = provider::timeconv::systemd_calendar("0 10 ? * 2#1 *", "Asia/Tokyo") # "Mon *-*-01..07 10:00:00 Asia/Tokyo"
+}
+
+output "from_unix_cron" {
+  value = provider::timeconv::systemd_calendar("*/5 * * * 1-5", "Asia/Tokyo") # "Mon..Fri *-*-* *:00/5:00 Asia/Tokyo"
+}
@@ -0,0 +1,6 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "sample" {
+  value = provider::timeconv::systemd_calendar_normalize("Mon..Fri 9:0 Asia/Tokyo") # "Mon..Fri *-*-* 09:00:00 Asia/Tokyo"
+}
@@ -0,0 +1,14 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+output "wareki" {
+  value = provider::timeconv::to_japanese_era("2026-10-18T09:00:00+09:00").date # "令和8年10月18日"
+}
+
+output "wareki_short" {
+  value = provider::timeconv::to_japanese_era("2019-05-01T00:00:00+09:00").short_date # "R1.5.1"
+}
+
+output "wareki_by_format" {
+  value = provider::timeconv::format("2019-05-01T00:00:00+09:00", "平成18年1月2日(Mon)", "ja-JP") # "令和元年5月1日(水)"
+}
@@ -1,4 +1,7 @@
 # Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
 # SPDX-License-Identifier: Apache-2.0
 
-provider "timeconv" {}
+provider "timeconv" {
+  # Pin the current time to make plans reproducible.
+  # now = "2024-07-01T00:00:00Z"
+}
@@ -0,0 +1,16 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+# Rotate every first Monday 10:00 in Tokyo.
+resource "timeconv_rotating" "credentials" {
+  schedule = "0 10 ? * MON#1 *"
+  location = "Asia/Tokyo"
+}
+
+resource "random_password" "credentials" {
+  length = 32
+
+  lifecycle {
+    replace_triggered_by = [timeconv_rotating.credentials]
+  }
+}
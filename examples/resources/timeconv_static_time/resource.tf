@@ -0,0 +1,18 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+variable "release_version" {
+  type = string
+}
+
+resource "timeconv_static_time" "release" {
+  output_location = "Asia/Tokyo"
+
+  triggers = {
+    version = var.release_version
+  }
+}
+
+output "released_at" {
+  value = timeconv_static_time.release.output
+}
@@ -0,0 +1,19 @@
+# Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+# SPDX-License-Identifier: Apache-2.0
+
+# Hold the cutover until the maintenance window opens at 02:00 in Tokyo.
+resource "timeconv_wait" "maintenance" {
+  schedule = "0 2 * * ? *"
+  location = "Asia/Tokyo"
+  max_wait = "3h"
+}
+
+resource "aws_route53_record" "cutover" {
+  zone_id = var.zone_id
+  name    = "app.example.com"
+  type    = "CNAME"
+  ttl     = 60
+  records = ["new.example.com"]
+
+  depends_on = [timeconv_wait.maintenance]
+}
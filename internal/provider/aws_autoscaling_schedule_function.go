@@ -0,0 +1,145 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const awsAutoscalingTimeFormat = "2006-01-02T15:04:05Z"
+
+var awsAutoscalingScheduleAttrTypes = map[string]attr.Type{
+	"recurrence": types.StringType,
+	"time_zone":  types.StringType,
+	"start_time": types.StringType,
+	"end_time":   types.StringType,
+}
+
+type awsAutoscalingSchedule struct{}
+
+// Definition implements function.Function.
+func (a *awsAutoscalingSchedule) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to Auto Scaling scheduled action arguments",
+		Description: "Convert an Amazon EventBridge or Unix cron expression, location and optional start/end times to `recurrence`, `time_zone`, `start_time` and `end_time` of `aws_autoscaling_schedule`. start_time must be after reference, end_time must be after start_time(or reference), and the recurrence must fire between them. See: https://docs.aws.amazon.com/autoscaling/ec2/userguide/ec2-auto-scaling-scheduled-scaling.html",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Amazon EventBridge cron format (w/o \"cron(...)\") or Unix cron format",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "start_time",
+				Description:    "Start time string in RFC3339 format",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "end_time",
+				Description:    "End time string in RFC3339 format",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "reference",
+				Description:    "Reference time string in RFC3339 format which start_time must be after. A fixed time keeps an applied start_time valid in later plans",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: awsAutoscalingScheduleAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (a *awsAutoscalingSchedule) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "aws_autoscaling_schedule"
+}
+
+// Run implements function.Function.
+func (a *awsAutoscalingSchedule) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var location types.String
+	var startTime, endTime, reference timetypes.RFC3339
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &location, &startTime, &endTime, &reference))
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := parseAwsOrUnixCron(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	fs, err := unixCronFields(expr, "Auto Scaling")
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	ref, _ := reference.ValueRFC3339Time()
+	from, start, end := ref, types.StringNull(), types.StringNull()
+	if !startTime.IsNull() {
+		t, _ := startTime.ValueRFC3339Time()
+		if !t.After(ref) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("start_time must be after %s: %s", ref.Format(time.RFC3339), startTime.ValueString())))
+			return
+		}
+		from, start = t, types.StringValue(t.UTC().Format(awsAutoscalingTimeFormat))
+	}
+	next := expr.Next(from.In(loc))
+	if !endTime.IsNull() {
+		t, _ := endTime.ValueRFC3339Time()
+		if !t.After(from) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, fmt.Sprintf("end_time must be after %s: %s", from.Format(time.RFC3339), endTime.ValueString())))
+			return
+		}
+		if next.IsZero() || next.After(t) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, fmt.Sprintf("recurrence never fires before end_time: %s", endTime.ValueString())))
+			return
+		}
+		end = types.StringValue(t.UTC().Format(awsAutoscalingTimeFormat))
+	}
+	if next.IsZero() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("recurrence never fires after %s", from.Format(time.RFC3339))))
+		return
+	}
+
+	output, diags := types.ObjectValue(awsAutoscalingScheduleAttrTypes, map[string]attr.Value{
+		"recurrence": types.StringValue(strings.Join(fs, " ")),
+		"time_zone":  types.StringValue(loc.String()),
+		"start_time": start,
+		"end_time":   end,
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*awsAutoscalingSchedule)(nil)
+
+func NewAwsAutoscalingScheduleFunction() function.Function {
+	return &awsAutoscalingSchedule{}
+}
@@ -0,0 +1,81 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAwsAutoscalingScheduleFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "recurrence_only" {
+					value = provider::timeconv::aws_autoscaling_schedule("0 9 ? * MON-FRI *", "Asia/Tokyo", null, null, "2029-01-01T00:00:00Z")
+				}
+				output "with_period" {
+					value = provider::timeconv::aws_autoscaling_schedule("0 9 * * 1-5", "Asia/Tokyo", "2030-01-01T00:00:00+09:00", "2030-02-01T00:00:00+09:00", "2029-01-01T00:00:00Z")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("recurrence_only", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"recurrence": knownvalue.StringExact("0 9 * * 1-5"),
+						"time_zone":  knownvalue.StringExact("Asia/Tokyo"),
+						"start_time": knownvalue.Null(),
+						"end_time":   knownvalue.Null(),
+					})),
+					statecheck.ExpectKnownOutputValue("with_period", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"recurrence": knownvalue.StringExact("0 9 * * 1-5"),
+						"time_zone":  knownvalue.StringExact("Asia/Tokyo"),
+						"start_time": knownvalue.StringExact("2029-12-31T15:00:00Z"),
+						"end_time":   knownvalue.StringExact("2030-01-31T15:00:00Z"),
+					})),
+				},
+			},
+			{
+				Config: `output "past_start_time" {
+					value = provider::timeconv::aws_autoscaling_schedule("0 9 * * 1-5", "Asia/Tokyo", "2020-01-01T00:00:00+09:00", null, "2029-01-01T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`start_time\s+must\s+be\s+after`),
+			},
+			{
+				Config: `output "end_before_start" {
+					value = provider::timeconv::aws_autoscaling_schedule("0 9 * * 1-5", "Asia/Tokyo", "2030-01-01T00:00:00+09:00", "2029-02-01T00:00:00+09:00", "2029-01-01T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`end_time\s+must\s+be\s+after`),
+			},
+			{
+				Config: `output "never_fires" {
+					value = provider::timeconv::aws_autoscaling_schedule("0 9 1 1 ? *", "Asia/Tokyo", "2030-01-02T00:00:00+09:00", "2030-02-01T00:00:00+09:00", "2029-01-01T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`never\s+fires\s+before\s+end_time`),
+			},
+			{
+				Config: `output "last_day" {
+					value = provider::timeconv::aws_autoscaling_schedule("0 9 L * ? *", null, null, null, "2029-01-01T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+day-of-month\s+"L"`),
+			},
+			{
+				Config: `output "null_reference" {
+					value = provider::timeconv::aws_autoscaling_schedule("0 9 * * 1-5", null, null, null, null)
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid\s+function\s+argument`),
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var awsScheduleExpressionAttrTypes = map[string]attr.Type{
+	"expression": types.StringType,
+	"timezone":   types.StringType,
+}
+
+type awsScheduleExpression struct{}
+
+// Definition implements function.Function.
+func (a *awsScheduleExpression) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to EventBridge Scheduler one-time schedule expression with timezone",
+		Description: "Convert to EventBridge Scheduler one-time schedule expression(\"at(...)\") and its IANA timezone name. The expression is formatted in the wall clock time of location, and timezone is the name of location, so they can be passed to `schedule_expression` and `schedule_expression_timezone` as is. If location is null, UTC is used. See: https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html#one-time",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in RFC3339 format",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: awsScheduleExpressionAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (a *awsScheduleExpression) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "aws_schedule_expression"
+}
+
+// Run implements function.Function.
+func (a *awsScheduleExpression) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input timetypes.RFC3339
+	var location types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &location))
+	if resp.Error != nil {
+		return
+	}
+
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	t, _ := input.ValueRFC3339Time()
+	output, diags := types.ObjectValue(awsScheduleExpressionAttrTypes, map[string]attr.Value{
+		"expression": types.StringValue(fmt.Sprintf("at(%s)", t.In(loc).Format("2006-01-02T15:04:05"))),
+		"timezone":   types.StringValue(loc.String()),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*awsScheduleExpression)(nil)
+
+func NewAwsScheduleExpressionFunction() function.Function {
+	return &awsScheduleExpression{}
+}
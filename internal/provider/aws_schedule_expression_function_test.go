@@ -0,0 +1,59 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAwsScheduleExpressionFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "in_tokyo" {
+					value = provider::timeconv::aws_schedule_expression("2024-08-31T01:23:45Z", "Asia/Tokyo")
+				}
+				output "without_location" {
+					value = provider::timeconv::aws_schedule_expression("2024-08-31T01:23:45+09:00", null)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("in_tokyo", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"expression": knownvalue.StringExact("at(2024-08-31T10:23:45)"),
+						"timezone":   knownvalue.StringExact("Asia/Tokyo"),
+					})),
+					statecheck.ExpectKnownOutputValue("without_location", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"expression": knownvalue.StringExact("at(2024-08-30T16:23:45)"),
+						"timezone":   knownvalue.StringExact("UTC"),
+					})),
+				},
+			},
+			{
+				Config: `output "invalid_location" {
+					value = provider::timeconv::aws_schedule_expression("2024-08-31T01:23:45Z", "invalid/location")
+				}`,
+				ExpectError: regexp.MustCompile(`unknown\s+time\s+zone`),
+			},
+			{
+				Config: `output "local_location" {
+					value = provider::timeconv::aws_schedule_expression("2024-08-31T01:23:45Z", "Local")
+				}`,
+				ExpectError: regexp.MustCompile(`must\s+be\s+an\s+IANA\s+timezone\s+name`),
+			},
+		},
+	})
+}
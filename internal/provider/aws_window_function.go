@@ -0,0 +1,382 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	awsWindowMinDuration = 30 * time.Minute
+	awsWindowMaxDuration = 24 * time.Hour
+)
+
+var awsWindowAttrTypes = map[string]attr.Type{
+	"day":      types.StringType,
+	"start":    types.StringType,
+	"duration": types.StringType,
+	"window":   types.StringType,
+}
+
+// parseWindowDay parses a day of week like "Sun", "sun" or "Sunday".
+func parseWindowDay(day string) (time.Weekday, error) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if len(day) >= 3 && strings.HasPrefix(strings.ToLower(wd.String()), strings.ToLower(day)) {
+			return wd, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid day of week: %q", day)
+}
+
+// parseWindowClock parses a time of day in "hh24:mi" format.
+func parseWindowClock(clock string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time of day %q, must be hh24:mi", clock)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// parseWindowDuration parses a window duration(golang time package style).
+func parseWindowDuration(duration string) (time.Duration, error) {
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return 0, err
+	}
+	if d < awsWindowMinDuration || d > awsWindowMaxDuration {
+		return 0, fmt.Errorf("duration must be between %s and %s: %s", formatWindowDuration(awsWindowMinDuration), formatWindowDuration(awsWindowMaxDuration), duration)
+	}
+	if d%time.Minute != 0 {
+		return 0, fmt.Errorf("duration must be in minutes: %s", duration)
+	}
+	return d, nil
+}
+
+// formatWindowDuration formats d like "1h30m" without zero units.
+func formatWindowDuration(d time.Duration) string {
+	var b strings.Builder
+	if h := int(d.Hours()); h > 0 {
+		fmt.Fprintf(&b, "%dh", h)
+	}
+	if m := int(d.Minutes()) % 60; m > 0 || d < time.Hour {
+		fmt.Fprintf(&b, "%dm", m)
+	}
+	return b.String()
+}
+
+// nextWindowStart returns the first time at hour:minute on wday(or any day if
+// wday is nil) in loc at or after ref.
+func nextWindowStart(ref time.Time, loc *time.Location, wday *time.Weekday, hour, minute int) time.Time {
+	ref = ref.In(loc)
+	for i := 0; ; i++ {
+		t := time.Date(ref.Year(), ref.Month(), ref.Day()+i, hour, minute, 0, 0, loc)
+		if (wday == nil || t.Weekday() == *wday) && !t.Before(ref) {
+			return t
+		}
+	}
+}
+
+// formatAwsWindow formats a window in "ddd:hh24:mi-ddd:hh24:mi" format, or
+// "hh24:mi-hh24:mi" format without days.
+func formatAwsWindow(start, end time.Time, withDay bool) string {
+	layout := "15:04"
+	if withDay {
+		layout = "Mon:15:04"
+	}
+	return strings.ToLower(start.Format(layout) + "-" + end.Format(layout))
+}
+
+// parseAwsWindow parses a window string in UTC and returns its next
+// occurrence at or after ref. The window must be between 30m and 24h.
+func parseAwsWindow(window string, ref time.Time) (time.Time, time.Time, error) {
+	from, to, ok := strings.Cut(window, "-")
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid window %q", window)
+	}
+	parse := func(s string) (*time.Weekday, int, int, error) {
+		var wday *time.Weekday
+		if strings.Count(s, ":") == 2 {
+			d, clock, _ := strings.Cut(s, ":")
+			wd, err := parseWindowDay(d)
+			if err != nil {
+				return nil, 0, 0, err
+			}
+			wday, s = &wd, clock
+		}
+		hour, minute, err := parseWindowClock(s)
+		return wday, hour, minute, err
+	}
+	startDay, startHour, startMinute, err := parse(from)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	endDay, endHour, endMinute, err := parse(to)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if (startDay == nil) != (endDay == nil) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid window %q", window)
+	}
+	start := nextWindowStart(ref, time.UTC, startDay, startHour, startMinute)
+	end := nextWindowStart(start.Add(time.Minute), time.UTC, endDay, endHour, endMinute)
+	if d := end.Sub(start); d < awsWindowMinDuration || d > awsWindowMaxDuration {
+		return time.Time{}, time.Time{}, fmt.Errorf("duration must be between %s and %s: %s", formatWindowDuration(awsWindowMinDuration), formatWindowDuration(awsWindowMaxDuration), window)
+	}
+	return start, end, nil
+}
+
+type awsMaintenanceWindow struct{}
+
+// Definition implements function.Function.
+func (a *awsMaintenanceWindow) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert local weekly window to AWS maintenance window string in UTC",
+		Description: "Convert a weekly window starting at the day and time in location to a maintenance window string in UTC(`ddd:hh24:mi-ddd:hh24:mi`) used by RDS, ElastiCache, Redshift, DocumentDB and so on. The UTC offset of the next window after reference is used. See: https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_UpgradeDBInstance.Maintenance.html#Concepts.DBMaintenance",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "day",
+				Description:    "Day of week when the window starts. like `Sun`, `sunday`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "start",
+				Description:    "Local time of day when the window starts in `hh24:mi` format",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "duration",
+				Description:    "Duration of the window(golang time package style) between 30m and 24h. like `1h`, `90m`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "Location string represents the timezone of day and start",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "reference",
+				Description:    "Reference time string in RFC3339 format which the next window is after. A fixed time keeps the result across DST changes",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (a *awsMaintenanceWindow) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "aws_maintenance_window"
+}
+
+// Run implements function.Function.
+func (a *awsMaintenanceWindow) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var day, start, duration, location string
+	var reference timetypes.RFC3339
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &day, &start, &duration, &location, &reference))
+	if resp.Error != nil {
+		return
+	}
+
+	wday, err := parseWindowDay(day)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	hour, minute, err := parseWindowClock(start)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	d, err := parseWindowDuration(duration)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+	loc, err := loadScheduleLocation(types.StringValue(location))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, err.Error()))
+		return
+	}
+
+	ref, _ := reference.ValueRFC3339Time()
+	t := nextWindowStart(ref, loc, &wday, hour, minute)
+	output := formatAwsWindow(t.UTC(), t.Add(d).UTC(), true)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*awsMaintenanceWindow)(nil)
+
+func NewAwsMaintenanceWindowFunction() function.Function {
+	return &awsMaintenanceWindow{}
+}
+
+type awsBackupWindow struct{}
+
+// Definition implements function.Function.
+func (a *awsBackupWindow) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert local daily window to AWS backup window string in UTC",
+		Description: "Convert a daily window starting at the time in location to a backup window string in UTC(`hh24:mi-hh24:mi`) used by RDS `preferred_backup_window`, ElastiCache `snapshot_window` and so on. The UTC offset of the next window after reference is used. See: https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_WorkingWithAutomatedBackups.html#USER_WorkingWithAutomatedBackups.BackupWindow",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "start",
+				Description:    "Local time of day when the window starts in `hh24:mi` format",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "duration",
+				Description:    "Duration of the window(golang time package style) between 30m and 24h. like `1h`, `90m`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "Location string represents the timezone of start",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "reference",
+				Description:    "Reference time string in RFC3339 format which the next window is after. A fixed time keeps the result across DST changes",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (a *awsBackupWindow) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "aws_backup_window"
+}
+
+// Run implements function.Function.
+func (a *awsBackupWindow) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var start, duration, location string
+	var reference timetypes.RFC3339
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &start, &duration, &location, &reference))
+	if resp.Error != nil {
+		return
+	}
+
+	hour, minute, err := parseWindowClock(start)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	d, err := parseWindowDuration(duration)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	loc, err := loadScheduleLocation(types.StringValue(location))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+
+	ref, _ := reference.ValueRFC3339Time()
+	t := nextWindowStart(ref, loc, nil, hour, minute)
+	output := formatAwsWindow(t.UTC(), t.Add(d).UTC(), false)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*awsBackupWindow)(nil)
+
+func NewAwsBackupWindowFunction() function.Function {
+	return &awsBackupWindow{}
+}
+
+type awsWindowInLocation struct{}
+
+// Definition implements function.Function.
+func (a *awsWindowInLocation) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert AWS maintenance or backup window string in UTC to local window",
+		Description: "Convert a maintenance window(`ddd:hh24:mi-ddd:hh24:mi`) or backup window(`hh24:mi-hh24:mi`) string in UTC to the window in location. It returns an object with `day`(null for backup window), `start` and `duration` which `aws_maintenance_window`/`aws_backup_window` accept, and `window` in the same format as input. The UTC offset of the next window after reference is used.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "window",
+				Description:    "Window string in UTC in `ddd:hh24:mi-ddd:hh24:mi` or `hh24:mi-hh24:mi` format",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "Output timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "reference",
+				Description:    "Reference time string in RFC3339 format which the next window is after. A fixed time keeps the result across DST changes",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: awsWindowAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (a *awsWindowInLocation) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "aws_window_in_location"
+}
+
+// Run implements function.Function.
+func (a *awsWindowInLocation) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var window, location string
+	var reference timetypes.RFC3339
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &window, &location, &reference))
+	if resp.Error != nil {
+		return
+	}
+
+	ref, _ := reference.ValueRFC3339Time()
+	start, end, err := parseAwsWindow(window, ref)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	loc, err := loadScheduleLocation(types.StringValue(location))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	withDay := strings.Count(window, ":") == 4
+	start, end = start.In(loc), end.In(loc)
+	day := types.StringNull()
+	if withDay {
+		day = types.StringValue(start.Format("Mon"))
+	}
+	output, diags := types.ObjectValue(awsWindowAttrTypes, map[string]attr.Value{
+		"day":      day,
+		"start":    types.StringValue(start.Format("15:04")),
+		"duration": types.StringValue(formatWindowDuration(end.Sub(start))),
+		"window":   types.StringValue(formatAwsWindow(start, end, withDay)),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*awsWindowInLocation)(nil)
+
+func NewAwsWindowInLocationFunction() function.Function {
+	return &awsWindowInLocation{}
+}
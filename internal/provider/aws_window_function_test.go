@@ -0,0 +1,174 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAwsMaintenanceWindowFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "previous_day" {
+					value = provider::timeconv::aws_maintenance_window("Sun", "03:00", "1h", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}
+				output "full_day_name" {
+					value = provider::timeconv::aws_maintenance_window("monday", "08:30", "90m", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}
+				output "across_days" {
+					value = provider::timeconv::aws_maintenance_window("Sat", "23:30", "1h", "UTC", "2026-10-18T00:00:00Z")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("previous_day", knownvalue.StringExact("sat:18:00-sat:19:00")),
+					statecheck.ExpectKnownOutputValue("full_day_name", knownvalue.StringExact("sun:23:30-mon:01:00")),
+					statecheck.ExpectKnownOutputValue("across_days", knownvalue.StringExact("sat:23:30-sun:00:30")),
+				},
+			},
+			{
+				Config: `output "too_short" {
+					value = provider::timeconv::aws_maintenance_window("Sat", "23:30", "10m", "UTC", "2026-10-18T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`duration\s+must\s+be\s+between\s+30m\s+and\s+24h`),
+			},
+			{
+				Config: `output "invalid_day" {
+					value = provider::timeconv::aws_maintenance_window("Xyz", "23:30", "1h", "UTC", "2026-10-18T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+day\s+of\s+week`),
+			},
+		},
+	})
+}
+
+func TestAwsBackupWindowFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `output "aws_backup_window" {
+					value = provider::timeconv::aws_backup_window("03:00", "30m", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("aws_backup_window", knownvalue.StringExact("18:00-18:30")),
+				},
+			},
+			{
+				Config: `output "invalid_start" {
+					value = provider::timeconv::aws_backup_window("25:30", "30m", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+time\s+of\s+day`),
+			},
+			{
+				Config: `output "local_location" {
+					value = provider::timeconv::aws_backup_window("03:00", "30m", "Local", "2026-10-18T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`location\s+must\s+be\s+an\s+IANA\s+timezone\s+name`),
+			},
+		},
+	})
+}
+
+func TestAwsBackupWindowFunctionReference(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "summer" {
+					value = provider::timeconv::aws_backup_window("03:00", "30m", "America/New_York", "2024-07-01T00:00:00Z")
+				}
+				output "winter" {
+					value = provider::timeconv::aws_backup_window("03:00", "30m", "America/New_York", "2024-12-01T00:00:00Z")
+				}
+				output "in_location_summer" {
+					value = provider::timeconv::aws_window_in_location("07:00-07:30", "America/New_York", "2024-07-01T00:00:00Z").start
+				}
+				output "in_location_winter" {
+					value = provider::timeconv::aws_window_in_location("07:00-07:30", "America/New_York", "2024-12-01T00:00:00Z").start
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("summer", knownvalue.StringExact("07:00-07:30")),
+					statecheck.ExpectKnownOutputValue("winter", knownvalue.StringExact("08:00-08:30")),
+					statecheck.ExpectKnownOutputValue("in_location_summer", knownvalue.StringExact("03:00")),
+					statecheck.ExpectKnownOutputValue("in_location_winter", knownvalue.StringExact("02:00")),
+				},
+			},
+		},
+	})
+}
+
+func TestAwsWindowInLocationFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "maintenance_window" {
+					value = provider::timeconv::aws_window_in_location("sat:23:30-sun:00:30", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}
+				output "backup_window" {
+					value = provider::timeconv::aws_window_in_location("18:00-18:30", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("maintenance_window", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"day":      knownvalue.StringExact("Sun"),
+						"start":    knownvalue.StringExact("08:30"),
+						"duration": knownvalue.StringExact("1h"),
+						"window":   knownvalue.StringExact("sun:08:30-sun:09:30"),
+					})),
+					statecheck.ExpectKnownOutputValue("backup_window", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"day":      knownvalue.Null(),
+						"start":    knownvalue.StringExact("03:00"),
+						"duration": knownvalue.StringExact("30m"),
+						"window":   knownvalue.StringExact("03:00-03:30"),
+					})),
+				},
+			},
+			{
+				Config: `output "mixed_window" {
+					value = provider::timeconv::aws_window_in_location("18:00-sat:18:30", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+window`),
+			},
+			{
+				Config: `output "too_long" {
+					value = provider::timeconv::aws_window_in_location("sat:23:30-mon:00:30", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`duration\s+must\s+be\s+between\s+30m\s+and\s+24h`),
+			},
+		},
+	})
+}
@@ -0,0 +1,240 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+const (
+	BLACKOUT_DS = "blackout"
+)
+
+var (
+	_ datasource.DataSource              = &blackoutDataSource{}
+	_ datasource.DataSourceWithConfigure = &blackoutDataSource{}
+)
+
+func NewBlackoutDataSource() datasource.DataSource {
+	return &blackoutDataSource{clock: systemClock{}}
+}
+
+type blackoutDataSource struct {
+	clock clock
+}
+
+func (d *blackoutDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_" + BLACKOUT_DS
+}
+
+func (d *blackoutDataSource) Schema(_ context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Description: "Checks whether the current time is inside one of the blackout windows. Each window is either schedule and duration, or start and end.",
+		Attributes: map[string]schema.Attribute{
+			"windows": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Blackout windows",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the window used in the diagnostic",
+						},
+						"schedule": schema.StringAttribute{
+							Optional:    true,
+							Description: "Start of the recurring window in Amazon EventBridge cron format (w/o \"cron(...)\") in location",
+						},
+						"duration": schema.StringAttribute{
+							Optional:    true,
+							Description: "Duration of the recurring window(golang time package style plus `d` for days). like `2h`, `3d`",
+						},
+						"start": schema.StringAttribute{
+							Optional:    true,
+							Description: "Start time of the window in RFC3339, or `2006-01-02T15:04:05` in location",
+						},
+						"end": schema.StringAttribute{
+							Optional:    true,
+							Description: "End time(exclusive) of the window in RFC3339, or `2006-01-02T15:04:05` in location",
+						},
+					},
+				},
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Description: "IANA timezone location of the windows. Default is UTC.",
+			},
+			"enforce": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, reading fails while a window is active. Set false to check active in a precondition instead. Default is true.",
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the current time is inside one of the windows",
+			},
+			"active_window": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name(or index) of the active window. Null if no window is active.",
+			},
+			"active_until": schema.StringAttribute{
+				Computed:    true,
+				Description: "End time of the active window in RFC3339 in location. Null if no window is active.",
+			},
+		},
+	}
+}
+
+func (d *blackoutDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*timeconvProviderData)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *timeconvProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.clock = data.runClock
+}
+
+// parseTimeInLocation parses s in RFC3339, or in local time without offset in
+// loc.
+func parseTimeInLocation(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02T15:04:05", s, loc)
+}
+
+// errBlackoutWindowOrder is the error of a window which ends before it starts.
+var errBlackoutWindowOrder = errors.New("end must be after start")
+
+// activeUntil returns the end of w if now is inside w, or the zero time.
+func (w *blackoutWindowModel) activeUntil(now time.Time, loc *time.Location) (time.Time, error) {
+	recurring := !w.Schedule.IsNull() || !w.Duration.IsNull()
+	explicit := !w.Start.IsNull() || !w.End.IsNull()
+	if recurring == explicit || w.Schedule.IsNull() != w.Duration.IsNull() || w.Start.IsNull() != w.End.IsNull() {
+		return time.Time{}, fmt.Errorf("either schedule and duration, or start and end must be set")
+	}
+
+	if explicit {
+		start, err := parseTimeInLocation(w.Start.ValueString(), loc)
+		if err != nil {
+			return time.Time{}, err
+		}
+		end, err := parseTimeInLocation(w.End.ValueString(), loc)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !end.After(start) {
+			return time.Time{}, fmt.Errorf("%w: %s is not after %s", errBlackoutWindowOrder, w.End.ValueString(), w.Start.ValueString())
+		}
+		if !now.Before(start) && now.Before(end) {
+			return end.In(loc), nil
+		}
+		return time.Time{}, nil
+	}
+
+	expr, err := cronplan.Parse(w.Schedule.ValueString())
+	if err != nil {
+		return time.Time{}, err
+	}
+	duration, err := parseDuration(w.Duration.ValueString())
+	if err != nil {
+		return time.Time{}, err
+	}
+	if duration <= 0 {
+		return time.Time{}, fmt.Errorf("duration must be positive: %q", w.Duration.ValueString())
+	}
+	_, end := currentRecurringWindow(expr, duration, now, loc)
+	return end, nil
+}
+
+func (d *blackoutDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config blackoutDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	loc, err := loadScheduleLocation(config.Location)
+	if err != nil {
+		res.Diagnostics.AddError(
+			"Location loading error",
+			"Cannot load the location.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	now := d.clock.Now()
+	state := config
+	state.Active = types.BoolValue(false)
+	state.ActiveWindow = types.StringNull()
+	state.ActiveUntil = types.StringNull()
+	for i, w := range config.Windows {
+		name := fmt.Sprintf("windows[%d]", i)
+		if !w.Name.IsNull() {
+			name = w.Name.ValueString()
+		}
+		end, err := w.activeUntil(now, loc)
+		if err != nil {
+			attr := path.Root("windows").AtListIndex(i)
+			if errors.Is(err, errBlackoutWindowOrder) {
+				attr = attr.AtName("end")
+			}
+			res.Diagnostics.AddAttributeError(
+				attr,
+				"Blackout window error",
+				fmt.Sprintf("Invalid blackout window %s.\n\n", name)+
+					fmt.Sprintf("Error: %s", err),
+			)
+			return
+		}
+		if !end.IsZero() && state.ActiveWindow.IsNull() {
+			state.Active = types.BoolValue(true)
+			state.ActiveWindow = types.StringValue(name)
+			state.ActiveUntil = types.StringValue(end.Format(time.RFC3339))
+		}
+	}
+
+	if state.Active.ValueBool() && (config.Enforce.IsNull() || config.Enforce.ValueBool()) {
+		res.Diagnostics.AddError(
+			"In blackout window",
+			fmt.Sprintf("Now(%s) is inside the blackout window %s until %s.", now.In(loc).Format(time.RFC3339), state.ActiveWindow.ValueString(), state.ActiveUntil.ValueString()),
+		)
+		return
+	}
+	diags = res.State.Set(ctx, state)
+	res.Diagnostics.Append(diags...)
+}
+
+type blackoutWindowModel struct {
+	Name     types.String `tfsdk:"name"`
+	Schedule types.String `tfsdk:"schedule"`
+	Duration types.String `tfsdk:"duration"`
+	Start    types.String `tfsdk:"start"`
+	End      types.String `tfsdk:"end"`
+}
+
+type blackoutDataSourceModel struct {
+	Windows      []blackoutWindowModel `tfsdk:"windows"`
+	Location     types.String          `tfsdk:"location"`
+	Enforce      types.Bool            `tfsdk:"enforce"`
+	Active       types.Bool            `tfsdk:"active"`
+	ActiveWindow types.String          `tfsdk:"active_window"`
+	ActiveUntil  types.String          `tfsdk:"active_until"`
+}
@@ -0,0 +1,90 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBlackoutDataSource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-06T12:00:00+09:00"
+				}
+				data "timeconv_blackout" "weekend" {
+					location = "Asia/Tokyo"
+					enforce  = false
+					windows = [
+						{
+							name  = "year-end"
+							start = "2024-12-28T00:00:00"
+							end   = "2025-01-04T00:00:00"
+						},
+						{
+							name     = "weekend"
+							schedule = "0 22 ? * FRI *"
+							duration = "2d"
+						},
+					]
+				}
+				data "timeconv_blackout" "year_end" {
+					location = "Asia/Tokyo"
+					windows = [
+						{
+							start = "2024-12-28T00:00:00"
+							end   = "2025-01-04T00:00:00"
+						},
+					]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.timeconv_blackout.weekend", "active", "true"),
+					resource.TestCheckResourceAttr("data.timeconv_blackout.weekend", "active_window", "weekend"),
+					resource.TestCheckResourceAttr("data.timeconv_blackout.weekend", "active_until", "2024-07-07T22:00:00+09:00"),
+					resource.TestCheckResourceAttr("data.timeconv_blackout.year_end", "active", "false"),
+					resource.TestCheckNoResourceAttr("data.timeconv_blackout.year_end", "active_window"),
+				),
+			},
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-12-31T12:00:00+09:00"
+				}
+				data "timeconv_blackout" "year_end" {
+					location = "Asia/Tokyo"
+					windows = [
+						{
+							start = "2024-12-28T00:00:00"
+							end   = "2025-01-04T00:00:00"
+						},
+					]
+				}
+				`,
+				ExpectError: regexp.MustCompile(`inside\s+the\s+blackout\s+window\s+windows\[0\]\s+until\s+2025-01-04T00:00:00\+09:00`),
+			},
+			{
+				Config: `
+				data "timeconv_blackout" "reversed" {
+					location = "Asia/Tokyo"
+					windows = [
+						{
+							name  = "reversed"
+							start = "2025-01-04T00:00:00"
+							end   = "2024-12-28T00:00:00"
+						},
+					]
+				}
+				`,
+				ExpectError: regexp.MustCompile(`end\s+must\s+be\s+after\s+start`),
+			},
+		},
+	})
+}
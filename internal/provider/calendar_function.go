@@ -0,0 +1,703 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Dates are converted through fixed day numbers(Rata Die), where 1 is
+// 0001-01-01 of the proleptic Gregorian calendar.
+const unixEpochFixed = 719163
+
+// calendarSystem is a calendar converted from and to fixed day numbers.
+type calendarSystem struct {
+	description string
+	// fromFixed returns the year, the month(or the week) and the day.
+	fromFixed func(fixed int) (int, int, int)
+	// toFixed returns the fixed day number, or an error if the date does not
+	// exist.
+	toFixed    func(year, month, day int) (int, error)
+	monthName  func(year, month int) string
+	leapYear   func(year int) bool
+	formatDate func(year, month, day int) string
+	// week is true if the calendar has weeks instead of months.
+	week bool
+}
+
+var (
+	calendarSystems = map[string]calendarSystem{
+		"thai_buddhist": {
+			description: "Thai Buddhist",
+			fromFixed: func(fixed int) (int, int, int) {
+				y, m, d := gregorianFromFixed(fixed)
+				return y + 543, m, d
+			},
+			toFixed: func(year, month, day int) (int, error) {
+				return fixedFromGregorian(year-543, month, day)
+			},
+			monthName: func(_, month int) string { return thaiMonths[month-1] },
+			leapYear:  func(year int) bool { return gregorianLeapYear(year - 543) },
+			formatDate: func(year, month, day int) string {
+				return fmt.Sprintf("%d %s %d", day, thaiMonths[month-1], year)
+			},
+		},
+		"persian": {
+			description: "Persian(Solar Hijri)",
+			fromFixed:   persianFromFixed,
+			toFixed:     fixedFromPersian,
+			monthName:   func(_, month int) string { return persianMonths[month-1] },
+			leapYear:    persianLeapYear,
+			formatDate: func(year, month, day int) string {
+				return fmt.Sprintf("%d %s %d", day, persianMonths[month-1], year)
+			},
+		},
+		"hebrew": {
+			description: "Hebrew",
+			fromFixed:   hebrewFromFixed,
+			toFixed:     fixedFromHebrew,
+			monthName:   hebrewMonthName,
+			leapYear:    hebrewLeapYear,
+			formatDate: func(year, month, day int) string {
+				return fmt.Sprintf("%d %s %d", day, hebrewMonthName(year, month), year)
+			},
+		},
+		"islamic_civil": {
+			description: "Islamic civil(tabular)",
+			fromFixed:   islamicFromFixed,
+			toFixed:     fixedFromIslamic,
+			monthName:   func(_, month int) string { return islamicMonths[month-1] },
+			leapYear:    islamicLeapYear,
+			formatDate: func(year, month, day int) string {
+				return fmt.Sprintf("%d %s %d", day, islamicMonths[month-1], year)
+			},
+		},
+		"islamic_umalqura": {
+			description: "Islamic Umm al-Qura",
+			fromFixed:   umalquraFromFixed,
+			toFixed:     fixedFromUmalqura,
+			monthName:   func(_, month int) string { return islamicMonths[month-1] },
+			leapYear:    umalquraLeapYear,
+			formatDate: func(year, month, day int) string {
+				return fmt.Sprintf("%d %s %d", day, islamicMonths[month-1], year)
+			},
+		},
+		"iso_week": {
+			description: "ISO week",
+			fromFixed: func(fixed int) (int, int, int) {
+				t := timeFromFixed(fixed, time.UTC)
+				year, week := t.ISOWeek()
+				return year, week, (int(t.Weekday())+6)%7 + 1
+			},
+			toFixed:   fixedFromISOWeek,
+			monthName: func(_, _ int) string { return "" },
+			leapYear: func(year int) bool {
+				_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+				return week == 53
+			},
+			formatDate: func(year, week, day int) string {
+				return fmt.Sprintf("%04d-W%02d-%d", year, week, day)
+			},
+			week: true,
+		},
+	}
+	thaiMonths = []string{
+		"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน",
+		"กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม",
+	}
+	persianMonths = []string{
+		"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
+		"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand",
+	}
+	// hebrewMonths are numbered from Nisan, and Adar is Adar I in leap years.
+	hebrewMonths = []string{
+		"Nisan", "Iyyar", "Sivan", "Tammuz", "Av", "Elul",
+		"Tishrei", "Marheshvan", "Kislev", "Tevet", "Shevat", "Adar", "Adar II",
+	}
+	islamicMonths = []string{
+		"Muharram", "Safar", "Rabi al-Awwal", "Rabi al-Thani", "Jumada al-Awwal", "Jumada al-Thani",
+		"Rajab", "Shaban", "Ramadan", "Shawwal", "Dhu al-Qadah", "Dhu al-Hijjah",
+	}
+	calendarAttrTypes = map[string]attr.Type{
+		"calendar":   types.StringType,
+		"year":       types.Int64Type,
+		"month":      types.Int64Type,
+		"week":       types.Int64Type,
+		"day":        types.Int64Type,
+		"month_name": types.StringType,
+		"leap_year":  types.BoolType,
+		"date":       types.StringType,
+		"formatted":  types.StringType,
+	}
+	calendarDatePattern = regexp.MustCompile(`^(-?\d+)-(\d{1,2})-(\d{1,2})$`)
+	calendarWeekPattern = regexp.MustCompile(`^(\d{4})-?W(\d{2})-?(\d)$`)
+)
+
+// calendarSystemNames returns the sorted names of calendarSystems.
+func calendarSystemNames() []string {
+	names := make([]string, 0, len(calendarSystems))
+	for name := range calendarSystems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadCalendarSystem returns the calendarSystem named name.
+func loadCalendarSystem(name string) (calendarSystem, error) {
+	c, ok := calendarSystems[name]
+	if !ok {
+		return calendarSystem{}, fmt.Errorf("unsupported calendar %q, must be one of `%s`", name, strings.Join(calendarSystemNames(), "`, `"))
+	}
+	return c, nil
+}
+
+// floorDiv returns a/b rounded toward negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns a mod b with the sign of b.
+func floorMod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
+
+// fixedFromTime returns the fixed day number of the date of t.
+func fixedFromTime(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()/86400) + unixEpochFixed
+}
+
+// timeFromFixed returns the start of the fixed day in loc.
+func timeFromFixed(fixed int, loc *time.Location) time.Time {
+	return time.Date(1970, time.January, 1+fixed-unixEpochFixed, 0, 0, 0, 0, loc)
+}
+
+func gregorianFromFixed(fixed int) (int, int, int) {
+	y, m, d := timeFromFixed(fixed, time.UTC).Date()
+	return y, int(m), d
+}
+
+func gregorianLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// fixedFromGregorian returns the fixed day number of the Gregorian date.
+func fixedFromGregorian(year, month, day int) (int, error) {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || int(t.Month()) != month || t.Day() != day {
+		return 0, fmt.Errorf("invalid date %04d-%02d-%02d", year, month, day)
+	}
+	return fixedFromTime(t), nil
+}
+
+// persianBreaks are the years of the Persian calendar when the leap year cycle
+// changes, so that the calendar follows the vernal equinox in Tehran.
+var persianBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// persianYear returns the number of years since the last leap year(0 for a
+// leap year) and the day in March of the Gregorian calendar when the Persian
+// year starts.
+func persianYear(year int) (leap, march int) {
+	gy := year + 621
+	leapJ, jp, jump := -14, persianBreaks[0], 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += floorDiv(jump, 33)*8 + floorDiv(floorMod(jump, 33), 4)
+		jp = jm
+	}
+	n := year - jp
+	leapJ += floorDiv(n, 33)*8 + floorDiv(floorMod(n, 33)+3, 4)
+	if floorMod(jump, 33) == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := floorDiv(gy, 4) - floorDiv((floorDiv(gy, 100)+1)*3, 4) - 150
+	march = 20 + leapJ - leapG
+	if jump-n < 6 {
+		n = n - jump + floorDiv(jump+4, 33)*33
+	}
+	leap = floorMod(floorMod(n+1, 33)-1, 4)
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, march
+}
+
+func persianLeapYear(year int) bool {
+	leap, _ := persianYear(year)
+	return leap == 0
+}
+
+func persianInRange(year int) bool {
+	return 1 <= year && year < persianBreaks[len(persianBreaks)-1]
+}
+
+func fixedFromPersian(year, month, day int) (int, error) {
+	if !persianInRange(year) || month < 1 || month > 12 || day < 1 || day > persianMonthLength(year, month) {
+		return 0, fmt.Errorf("invalid Persian date %04d-%02d-%02d", year, month, day)
+	}
+	_, march := persianYear(year)
+	start, _ := fixedFromGregorian(year+621, 3, march)
+	return start + (month-1)*31 - floorDiv(month, 7)*(month-7) + day - 1, nil
+}
+
+func persianMonthLength(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11 || persianLeapYear(year):
+		return 30
+	}
+	return 29
+}
+
+func persianFromFixed(fixed int) (int, int, int) {
+	gy, _, _ := gregorianFromFixed(fixed)
+	year := gy - 621
+	leap, march := persianYear(year)
+	start, _ := fixedFromGregorian(gy, 3, march)
+	k := fixed - start
+	if k >= 0 {
+		if k <= 185 {
+			return year, 1 + k/31, k%31 + 1
+		}
+		k -= 186
+	} else {
+		year--
+		k += 179
+		if leap == 1 {
+			k++
+		}
+	}
+	return year, 7 + k/30, k%30 + 1
+}
+
+// hebrewEpoch is the fixed day number of 1 Tishrei AM 1.
+const hebrewEpoch = -1373427
+
+func hebrewLeapYear(year int) bool {
+	return floorMod(7*year+1, 19) < 7
+}
+
+// hebrewElapsedDays returns the days from the epoch to the molad of Tishrei
+// of the year, postponed if it falls on Sunday, Wednesday or Friday.
+func hebrewElapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if floorMod(3*(days+1), 7) < 3 {
+		days++
+	}
+	return days
+}
+
+// hebrewNewYear returns the fixed day number of 1 Tishrei of the year.
+func hebrewNewYear(year int) int {
+	ny0, ny1, ny2 := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	delay := 0
+	if ny2-ny1 == 356 {
+		delay = 2
+	} else if ny1-ny0 == 382 {
+		delay = 1
+	}
+	return hebrewEpoch + ny1 + delay
+}
+
+func hebrewMonthLength(year, month int) int {
+	days := hebrewNewYear(year+1) - hebrewNewYear(year)
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13:
+		return 29
+	case month == 12 && !hebrewLeapYear(year):
+		return 29
+	case month == 8 && days%10 != 5:
+		return 29
+	case month == 9 && days%10 == 3:
+		return 29
+	}
+	return 30
+}
+
+func hebrewMonthName(year, month int) string {
+	if month == 12 && hebrewLeapYear(year) {
+		return "Adar I"
+	}
+	return hebrewMonths[month-1]
+}
+
+func fixedFromHebrew(year, month, day int) (int, error) {
+	last := 12
+	if hebrewLeapYear(year) {
+		last = 13
+	}
+	if year < 1 || month < 1 || month > last || day < 1 || day > hebrewMonthLength(year, month) {
+		return 0, fmt.Errorf("invalid Hebrew date %04d-%02d-%02d", year, month, day)
+	}
+	fixed := hebrewNewYear(year) + day - 1
+	// The year starts with Tishrei(7), and Nisan(1) follows the last month.
+	if month < 7 {
+		for m := 7; m <= last; m++ {
+			fixed += hebrewMonthLength(year, m)
+		}
+		for m := 1; m < month; m++ {
+			fixed += hebrewMonthLength(year, m)
+		}
+	} else {
+		for m := 7; m < month; m++ {
+			fixed += hebrewMonthLength(year, m)
+		}
+	}
+	return fixed, nil
+}
+
+func hebrewFromFixed(fixed int) (int, int, int) {
+	year := floorDiv((fixed-hebrewEpoch)*98496, 35975351) + 1
+	for hebrewNewYear(year) > fixed {
+		year--
+	}
+	for hebrewNewYear(year+1) <= fixed {
+		year++
+	}
+	month := 7
+	if nisan, _ := fixedFromHebrew(year, 1, 1); fixed >= nisan {
+		month = 1
+	}
+	for {
+		first, _ := fixedFromHebrew(year, month, 1)
+		if fixed < first+hebrewMonthLength(year, month) {
+			return year, month, fixed - first + 1
+		}
+		month++
+	}
+}
+
+// islamicEpoch is the fixed day number of 1 Muharram AH 1 in the civil
+// epoch(622-07-16 of the Julian calendar).
+const islamicEpoch = 227015
+
+func islamicLeapYear(year int) bool {
+	return floorMod(14+11*year, 30) < 11
+}
+
+func fixedFromIslamic(year, month, day int) (int, error) {
+	length := 29 + month%2
+	if month == 12 && islamicLeapYear(year) {
+		length = 30
+	}
+	if year < 1 || month < 1 || month > 12 || day < 1 || day > length {
+		return 0, fmt.Errorf("invalid Islamic date %04d-%02d-%02d", year, month, day)
+	}
+	return islamicEpoch - 1 + (year-1)*354 + floorDiv(3+11*year, 30) + 29*(month-1) + month/2 + day, nil
+}
+
+func islamicFromFixed(fixed int) (int, int, int) {
+	year := floorDiv(30*(fixed-islamicEpoch)+10646, 10631)
+	first, _ := fixedFromIslamic(year, 1, 1)
+	month := floorDiv(11*(fixed-first)+330, 325)
+	start, _ := fixedFromIslamic(year, month, 1)
+	return year, month, fixed - start + 1
+}
+
+// umalquraFirstYear is the first year of umalquraMonthLengths.
+const umalquraFirstYear = 1300
+
+// umalquraEpoch is the fixed day number of 1 Muharram AH 1300(1882-11-12).
+const umalquraEpoch = 687337
+
+// umalquraMonthLengths are the month lengths of the Umm al-Qura calendar of
+// Saudi Arabia from AH 1300 to 1600 by year, where bit n-1 is set if month n
+// has 30 days instead of 29.
+var umalquraMonthLengths = []uint16{
+	0x555, 0x2ab, 0x937, 0x2b6, 0x576, 0x36c, 0xb55, 0xaaa, 0x956, 0x49e, // 1300
+	0x95d, 0x2ba, 0x5b5, 0x3aa, 0xb4b, 0xa96, 0x52e, 0x2ad, 0x56d, 0xb5a, // 1310
+	0x752, 0xf25, 0xe8a, 0xd16, 0xa56, 0xab5, 0x6b4, 0xda9, 0xb92, 0xb25, // 1320
+	0x64b, 0xa9b, 0x35a, 0x6d9, 0x5d4, 0xda5, 0xd4a, 0xa95, 0x536, 0x975, // 1330
+	0x2f4, 0x6e9, 0x6d4, 0x6a9, 0x535, 0x25d, 0x4bd, 0x9ba, 0x3b4, 0xb69, // 1340
+	0xb2a, 0xa55, 0x4ad, 0xa5d, 0x2da, 0x6d9, 0xeaa, 0xe94, 0xd2a, 0xc56, // 1350
+	0x4ae, 0xa6d, 0x56a, 0xd55, 0xd4a, 0xa93, 0x52b, 0xa5b, 0x53a, 0x6b5, // 1360
+	0xea9, 0xd52, 0xd29, 0xa55, 0x4ad, 0x56d, 0xaea, 0x6e4, 0xed1, 0xda2, // 1370
+	0xaaa, 0x95a, 0x2da, 0x5b9, 0xbb2, 0x764, 0x6c9, 0x555, 0x2ab, 0x4db, // 1380
+	0xaba, 0x5b4, 0xda9, 0xd52, 0xaa5, 0x92d, 0x26d, 0x8ed, 0x2da, 0xad5, // 1390
+	0xaa5, 0xa4b, 0x497, 0x937, 0x2b6, 0x975, 0xd69, 0xd52, 0xc95, 0x92b, // 1400
+	0x25b, 0x4db, 0x9d5, 0x5d2, 0xda5, 0xd4a, 0xa95, 0x54d, 0xaad, 0x3aa, // 1410
+	0xbd2, 0xbc4, 0xb89, 0xa95, 0x52d, 0x5ad, 0xb6a, 0x6d4, 0xdc9, 0xd92, // 1420
+	0xaa6, 0x956, 0x2ae, 0x56d, 0x36a, 0xb55, 0xaaa, 0x94d, 0x49d, 0x95d, // 1430
+	0x2ba, 0x5b5, 0x5aa, 0xd55, 0xa9a, 0x92e, 0x26e, 0x55d, 0xada, 0x6d4, // 1440
+	0x6a5, 0xb27, 0xa4d, 0x4ad, 0x56d, 0xb5a, 0x754, 0xf49, 0xe92, 0xd26, // 1450
+	0xa56, 0x356, 0x6b5, 0xbaa, 0xb92, 0xb25, 0x68b, 0xa9b, 0x55a, 0xada, // 1460
+	0x5b4, 0xda9, 0xb52, 0xa9a, 0x536, 0x276, 0x575, 0xaf2, 0x6d4, 0x6a9, // 1470
+	0x555, 0x2ad, 0x4bd, 0x9ba, 0x574, 0xb69, 0xb52, 0xa95, 0x52d, 0xa5d, // 1480
+	0x4da, 0xad9, 0x6b2, 0xe95, 0xe2a, 0xc96, 0x92e, 0xaad, 0x56a, 0xd65, // 1490
+	0xd4a, 0xd15, 0x62b, 0xc5b, 0x53a, 0x6b5, 0xdb2, 0xd64, 0xd29, 0xa55, // 1500
+	0x4ad, 0x96d, 0xaea, 0x6e8, 0xed1, 0xda4, 0xd4a, 0xa6a, 0x2da, 0x5b9, // 1510
+	0xb72, 0xb68, 0x6d1, 0x655, 0x4ab, 0x95b, 0x2ba, 0x5b5, 0xda9, 0xd52, // 1520
+	0xca6, 0x94e, 0x46e, 0x95d, 0x4da, 0xad5, 0xaaa, 0xa4d, 0x49b, 0x937, // 1530
+	0x4b6, 0x975, 0xd6a, 0xd52, 0xaa5, 0x94b, 0x2ab, 0x55b, 0xad9, 0x5d2, // 1540
+	0xdc5, 0xd92, 0xb25, 0x555, 0xab5, 0x5b4, 0xba9, 0x7a2, 0x745, 0x593, // 1550
+	0xaab, 0x4d6, 0x9d6, 0x5d2, 0xba5, 0xb4a, 0xa95, 0x4ad, 0x15d, 0x2dd, // 1560
+	0x9da, 0x5b4, 0x5a9, 0x52d, 0x25b, 0x8b7, 0x176, 0x56d, 0xb6a, 0xaca, // 1570
+	0xa96, 0x52b, 0x15b, 0x2bb, 0x5b6, 0xdaa, 0xb94, 0xd46, 0xa8d, 0x52d, // 1580
+	0xa9d, 0x55a, 0x755, 0x749, 0xf13, 0xe4a, 0xa96, 0x556, 0x6b5, 0xbaa, // 1590
+	0xb94, // 1600
+}
+
+func umalquraInRange(year int) bool {
+	return umalquraFirstYear <= year && year < umalquraFirstYear+len(umalquraMonthLengths)
+}
+
+func umalquraMonthLength(year, month int) int {
+	return 29 + int(umalquraMonthLengths[year-umalquraFirstYear]>>(month-1)&1)
+}
+
+func umalquraYearLength(year int) int {
+	return 12*29 + bits.OnesCount16(umalquraMonthLengths[year-umalquraFirstYear])
+}
+
+// umalquraLeapYear reports whether the year has 355 days.
+func umalquraLeapYear(year int) bool {
+	return umalquraInRange(year) && umalquraYearLength(year) > 354
+}
+
+func fixedFromUmalqura(year, month, day int) (int, error) {
+	if !umalquraInRange(year) || month < 1 || month > 12 || day < 1 || day > umalquraMonthLength(year, month) {
+		return 0, fmt.Errorf("invalid Umm al-Qura date %04d-%02d-%02d", year, month, day)
+	}
+	fixed := umalquraEpoch + day - 1
+	for y := umalquraFirstYear; y < year; y++ {
+		fixed += umalquraYearLength(y)
+	}
+	for m := 1; m < month; m++ {
+		fixed += umalquraMonthLength(year, m)
+	}
+	return fixed, nil
+}
+
+// umalquraFromFixed returns year 0 for dates out of the table, which
+// fixedFromUmalqura rejects.
+func umalquraFromFixed(fixed int) (int, int, int) {
+	if fixed < umalquraEpoch {
+		return 0, 0, 0
+	}
+	start := umalquraEpoch
+	for year := umalquraFirstYear; umalquraInRange(year); year++ {
+		if fixed >= start+umalquraYearLength(year) {
+			start += umalquraYearLength(year)
+			continue
+		}
+		for month := 1; ; month++ {
+			if fixed < start+umalquraMonthLength(year, month) {
+				return year, month, fixed - start + 1
+			}
+			start += umalquraMonthLength(year, month)
+		}
+	}
+	return 0, 0, 0
+}
+
+// fixedFromISOWeek returns the fixed day number of the weekday(1 for Monday)
+// in the ISO week of the year.
+func fixedFromISOWeek(year, week, day int) (int, error) {
+	_, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	if week < 1 || week > weeks || day < 1 || day > 7 {
+		return 0, fmt.Errorf("invalid ISO week date %04d-W%02d-%d", year, week, day)
+	}
+	// January 4th is always in the first week.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := fixedFromTime(jan4) - (int(jan4.Weekday())+6)%7
+	return monday + (week-1)*7 + day - 1, nil
+}
+
+// parseCalendarDate parses s in the `date` format of c.
+func parseCalendarDate(s string, c calendarSystem) (int, int, int, error) {
+	pattern := calendarDatePattern
+	if c.week {
+		pattern = calendarWeekPattern
+	}
+	m := pattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, 0, 0, fmt.Errorf("invalid %s date %q", c.description, s)
+	}
+	year, _ := strconv.Atoi(m[1])
+	month, _ := strconv.Atoi(m[2])
+	day, _ := strconv.Atoi(m[3])
+	return year, month, day, nil
+}
+
+type calendarConvert struct{}
+
+// Definition implements function.Function.
+func (c *calendarConvert) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a time to a date of the calendar",
+		Description: fmt.Sprintf("Convert the date of a time string in its UTC offset to the calendar. Calendars are `%s`. It returns an object with `calendar`, `year`, `month`(null for `iso_week`), `week`(null except for `iso_week`), `day`(the weekday from 1 for Monday for `iso_week`), `month_name`, `leap_year`(53 weeks for `iso_week`), `date`(like `1405-07-26` or `2026-W42-7`) and `formatted`(like `26 Mehr 1405`). Days start at midnight, not at sunset. `islamic_civil` is the arithmetic calendar, which can differ by a day or two from the observed calendars. `islamic_umalqura` is the Umm al-Qura calendar of Saudi Arabia by its published table, only from AH 1300 to 1600(1882-11-12 to 2174-11-25). Hebrew months are numbered from Nisan, and `Adar` is `Adar I` in leap years. Month names are transliterated into English except for `thai_buddhist`.", strings.Join(calendarSystemNames(), "`, `")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in RFC3339 format",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "calendar",
+				Description:    "Calendar to convert to",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: calendarAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (c *calendarConvert) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "calendar_convert"
+}
+
+// Run implements function.Function.
+func (c *calendarConvert) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input timetypes.RFC3339
+	var calendar string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &calendar))
+	if resp.Error != nil {
+		return
+	}
+
+	cal, err := loadCalendarSystem(calendar)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	t, _ := input.ValueRFC3339Time()
+	year, month, day := cal.fromFixed(fixedFromTime(t))
+	// Dates before the epoch or beyond the known leap years cannot be
+	// converted back.
+	if _, err := cal.toFixed(year, month, day); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%s is out of the range of the %s calendar", t.Format(time.DateOnly), cal.description)))
+		return
+	}
+
+	values := map[string]attr.Value{
+		"calendar":   types.StringValue(calendar),
+		"year":       types.Int64Value(int64(year)),
+		"month":      types.Int64Value(int64(month)),
+		"week":       types.Int64Null(),
+		"day":        types.Int64Value(int64(day)),
+		"month_name": types.StringValue(cal.monthName(year, month)),
+		"leap_year":  types.BoolValue(cal.leapYear(year)),
+		"date":       types.StringValue(fmt.Sprintf("%04d-%02d-%02d", year, month, day)),
+		"formatted":  types.StringValue(cal.formatDate(year, month, day)),
+	}
+	if cal.week {
+		values["month"], values["week"], values["month_name"] = types.Int64Null(), types.Int64Value(int64(month)), types.StringNull()
+		values["date"] = values["formatted"]
+	}
+	output, diags := types.ObjectValue(calendarAttrTypes, values)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*calendarConvert)(nil)
+
+func NewCalendarConvertFunction() function.Function {
+	return &calendarConvert{}
+}
+
+type calendarParse struct{}
+
+// Definition implements function.Function.
+func (c *calendarParse) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a date of the calendar to a time",
+		Description: fmt.Sprintf("Convert a date of the calendar in the `date` format of `calendar_convert`(`yyyy-mm-dd`, or `yyyy-Www-d` for `iso_week`) to the time string at the start of the day in location in RFC3339. Calendars are `%s`. If location is null, UTC is used.", strings.Join(calendarSystemNames(), "`, `")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input date string of the calendar. like `1405-07-26`, `5787-07-07`, `2026-W42-7`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "calendar",
+				Description:    "Calendar of the input",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `UTC`, `Asia/Tehran`, `Asia/Jerusalem`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (c *calendarParse) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "calendar_parse"
+}
+
+// Run implements function.Function.
+func (c *calendarParse) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, calendar string
+	var location types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &calendar, &location))
+	if resp.Error != nil {
+		return
+	}
+
+	cal, err := loadCalendarSystem(calendar)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+	year, month, day, err := parseCalendarDate(input, cal)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	fixed, err := cal.toFixed(year, month, day)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	t := timeFromFixed(fixed, loc)
+	// RFC3339 has only four digits for the year.
+	if t.Year() < 1 || t.Year() > 9999 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%q is out of range, the Gregorian year must be between 1 and 9999", input)))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, timetypes.NewRFC3339TimeValue(t)))
+}
+
+var _ function.Function = (*calendarParse)(nil)
+
+func NewCalendarParseFunction() function.Function {
+	return &calendarParse{}
+}
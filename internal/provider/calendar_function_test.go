@@ -0,0 +1,210 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCalendarConvertFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "persian" {
+					value = provider::timeconv::calendar_convert("2026-10-18T09:00:00+03:30", "persian")
+				}
+				output "nowruz" {
+					value = provider::timeconv::calendar_convert("2026-03-21T00:00:00+03:30", "persian")
+				}
+				output "hebrew" {
+					value = provider::timeconv::calendar_convert("2026-09-12T00:00:00+03:00", "hebrew")
+				}
+				output "adar_ii" {
+					value = provider::timeconv::calendar_convert("2024-03-11T00:00:00Z", "hebrew")
+				}
+				output "islamic_civil" {
+					value = provider::timeconv::calendar_convert("2026-02-18T00:00:00Z", "islamic_civil")
+				}
+				output "islamic_umalqura" {
+					value = provider::timeconv::calendar_convert("2026-10-18T00:00:00+03:00", "islamic_umalqura")
+				}
+				output "islamic_civil_differs" {
+					value = provider::timeconv::calendar_convert("2026-10-18T00:00:00+03:00", "islamic_civil")
+				}
+				output "thai_buddhist" {
+					value = provider::timeconv::calendar_convert("2026-10-18T00:00:00+07:00", "thai_buddhist")
+				}
+				output "iso_week" {
+					value = provider::timeconv::calendar_convert("2021-01-01T00:00:00Z", "iso_week")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("persian", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"calendar":   knownvalue.StringExact("persian"),
+						"year":       knownvalue.Int64Exact(1405),
+						"month":      knownvalue.Int64Exact(7),
+						"week":       knownvalue.Null(),
+						"day":        knownvalue.Int64Exact(26),
+						"month_name": knownvalue.StringExact("Mehr"),
+						"leap_year":  knownvalue.Bool(false),
+						"date":       knownvalue.StringExact("1405-07-26"),
+						"formatted":  knownvalue.StringExact("26 Mehr 1405"),
+					})),
+					statecheck.ExpectKnownOutputValue("nowruz", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"formatted": knownvalue.StringExact("1 Farvardin 1405"),
+					})),
+					statecheck.ExpectKnownOutputValue("hebrew", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"date":      knownvalue.StringExact("5787-07-01"),
+						"formatted": knownvalue.StringExact("1 Tishrei 5787"),
+						"leap_year": knownvalue.Bool(true),
+					})),
+					statecheck.ExpectKnownOutputValue("adar_ii", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"month":      knownvalue.Int64Exact(13),
+						"month_name": knownvalue.StringExact("Adar II"),
+					})),
+					statecheck.ExpectKnownOutputValue("islamic_civil", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"formatted": knownvalue.StringExact("1 Ramadan 1447"),
+					})),
+					statecheck.ExpectKnownOutputValue("islamic_umalqura", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"date":      knownvalue.StringExact("1448-05-07"),
+						"formatted": knownvalue.StringExact("7 Jumada al-Awwal 1448"),
+						"leap_year": knownvalue.Bool(true),
+					})),
+					statecheck.ExpectKnownOutputValue("islamic_civil_differs", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"formatted": knownvalue.StringExact("6 Jumada al-Awwal 1448"),
+					})),
+					statecheck.ExpectKnownOutputValue("thai_buddhist", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"date":      knownvalue.StringExact("2569-10-18"),
+						"formatted": knownvalue.StringExact("18 ตุลาคม 2569"),
+					})),
+					statecheck.ExpectKnownOutputValue("iso_week", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"calendar":   knownvalue.StringExact("iso_week"),
+						"year":       knownvalue.Int64Exact(2020),
+						"month":      knownvalue.Null(),
+						"week":       knownvalue.Int64Exact(53),
+						"day":        knownvalue.Int64Exact(5),
+						"month_name": knownvalue.Null(),
+						"leap_year":  knownvalue.Bool(true),
+						"date":       knownvalue.StringExact("2020-W53-5"),
+						"formatted":  knownvalue.StringExact("2020-W53-5"),
+					})),
+				},
+			},
+			{
+				Config: `output "unsupported" {
+					value = provider::timeconv::calendar_convert("2026-10-18T00:00:00Z", "gregorian")
+				}`,
+				ExpectError: regexp.MustCompile(`unsupported\s+calendar`),
+			},
+			{
+				Config: `output "before_epoch" {
+					value = provider::timeconv::calendar_convert("0600-01-01T00:00:00Z", "islamic_civil")
+				}`,
+				ExpectError: regexp.MustCompile(`out\s+of\s+the\s+range`),
+			},
+			{
+				Config: `output "after_table" {
+					value = provider::timeconv::calendar_convert("2174-11-26T00:00:00Z", "islamic_umalqura")
+				}`,
+				ExpectError: regexp.MustCompile(`out\s+of\s+the\s+range`),
+			},
+		},
+	})
+}
+
+func TestCalendarParseFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "persian" {
+					value = provider::timeconv::calendar_parse("1405-07-26", "persian", "Asia/Tehran")
+				}
+				output "hebrew" {
+					value = provider::timeconv::calendar_parse("5784-13-01", "hebrew", null)
+				}
+				output "islamic_civil" {
+					value = provider::timeconv::calendar_parse("1447-09-01", "islamic_civil", null)
+				}
+				output "islamic_umalqura" {
+					value = provider::timeconv::calendar_parse("1445-10-01", "islamic_umalqura", "Asia/Riyadh")
+				}
+				output "thai_buddhist" {
+					value = provider::timeconv::calendar_parse("2569-10-18", "thai_buddhist", "Asia/Bangkok")
+				}
+				output "iso_week" {
+					value = provider::timeconv::calendar_parse("2026-W42-7", "iso_week", null)
+				}
+				output "iso_week_basic" {
+					value = provider::timeconv::calendar_parse("2020W535", "iso_week", null)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("persian", knownvalue.StringExact("2026-10-18T00:00:00+03:30")),
+					statecheck.ExpectKnownOutputValue("hebrew", knownvalue.StringExact("2024-03-11T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("islamic_civil", knownvalue.StringExact("2026-02-18T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("islamic_umalqura", knownvalue.StringExact("2024-04-10T00:00:00+03:00")),
+					statecheck.ExpectKnownOutputValue("thai_buddhist", knownvalue.StringExact("2026-10-18T00:00:00+07:00")),
+					statecheck.ExpectKnownOutputValue("iso_week", knownvalue.StringExact("2026-10-18T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("iso_week_basic", knownvalue.StringExact("2021-01-01T00:00:00Z")),
+				},
+			},
+			{
+				Config: `output "not_a_leap_year" {
+					value = provider::timeconv::calendar_parse("1404-12-30", "persian", null)
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+Persian\s+date`),
+			},
+			{
+				Config: `output "no_adar_ii" {
+					value = provider::timeconv::calendar_parse("5785-13-01", "hebrew", null)
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+Hebrew\s+date`),
+			},
+			{
+				Config: `output "no_week_53" {
+					value = provider::timeconv::calendar_parse("2021-W53-1", "iso_week", null)
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+ISO\s+week\s+date`),
+			},
+			{
+				Config: `output "before_table" {
+					value = provider::timeconv::calendar_parse("1299-12-01", "islamic_umalqura", null)
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+Umm\s+al-Qura\s+date`),
+			},
+			{
+				Config: `output "after_year_9999" {
+					value = provider::timeconv::calendar_parse("14000-01-01", "hebrew", null)
+				}`,
+				ExpectError: regexp.MustCompile(`year\s+must\s+be\s+between\s+1\s+and\s+9999`),
+			},
+			{
+				Config: `output "before_year_1" {
+					value = provider::timeconv::calendar_parse("-5-10-18", "thai_buddhist", null)
+				}`,
+				ExpectError: regexp.MustCompile(`year\s+must\s+be\s+between\s+1\s+and\s+9999`),
+			},
+		},
+	})
+}
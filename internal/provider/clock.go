@@ -0,0 +1,100 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NOW_ENV is the environment variable which pins the clock.
+const NOW_ENV = "TIMECONV_NOW"
+
+// clock provides the current time to the time dependent code paths.
+type clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// newClock returns the clock pinned to now in RFC3339, or the system clock if
+// now is empty.
+func newClock(now string) (clock, error) {
+	if now == "" {
+		return systemClock{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, now)
+	if err != nil {
+		return nil, fmt.Errorf("now must be RFC3339 time string: %w", err)
+	}
+	return fixedClock(t), nil
+}
+
+// envClock returns the clock pinned by NOW_ENV, or the system clock if it is
+// not set. The provider uses it when now is not configured.
+func envClock() (clock, error) {
+	c, err := newClock(os.Getenv(NOW_ENV))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", NOW_ENV, err)
+	}
+	return c, nil
+}
+
+// floorTime floors t to the last boundary of granularity in loc. granularity
+// is a duration(golang time package style, plus `d` for days) aligned to
+// midnight of 0001-01-01 in loc, or an Amazon EventBridge or Unix cron
+// expression.
+func floorTime(t time.Time, granularity string, loc *time.Location) (time.Time, error) {
+	t = t.In(loc)
+	if strings.Contains(strings.TrimSpace(granularity), " ") {
+		expr, err := parseAwsOrUnixCron(granularity)
+		if err != nil {
+			return time.Time{}, err
+		}
+		for days := 1; days <= 4096; days *= 2 {
+			if schedule := expr.Between(t.AddDate(0, 0, -days), t); len(schedule) > 0 {
+				return schedule[len(schedule)-1], nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("no boundary of %q before %s", granularity, t.Format(time.RFC3339))
+	}
+
+	d, err := parseDuration(granularity)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid granularity %q: %w", granularity, err)
+	}
+	if d <= 0 {
+		return time.Time{}, fmt.Errorf("granularity must be positive: %q", granularity)
+	}
+	// Floor the wall clock time so that boundaries do not move with the UTC
+	// offset.
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).Truncate(d)
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), loc), nil
+}
+
+// parseDuration parses s in golang time package style, or an integer with `d`
+// suffix for days.
+func parseDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,188 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+var cronScheduleAttrTypes = map[string]attr.Type{
+	"schedule":  types.StringType,
+	"time_zone": types.StringType,
+}
+
+// runCronSchedule converts an EventBridge cron expression into the Unix cron
+// schedule of target together with its timezone name.
+func runCronSchedule(ctx context.Context, req function.RunRequest, resp *function.RunResponse, target string) {
+	var input string
+	var location types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &location))
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := cronplan.Parse(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	fs, err := unixCronFields(expr, target)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	output, diags := types.ObjectValue(cronScheduleAttrTypes, map[string]attr.Value{
+		"schedule":  types.StringValue(strings.Join(fs, " ")),
+		"time_zone": types.StringValue(loc.String()),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+type gcpSchedule struct{}
+
+// Definition implements function.Function.
+func (g *gcpSchedule) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to Google Cloud Scheduler schedule with timezone",
+		Description: "Convert an Amazon EventBridge cron expression to Google Cloud Scheduler unix-cron `schedule` and `time_zone`. Constructs unix-cron cannot express(`L`, `W`, `#` and year) are reported as errors. If location is null, UTC is used. See: https://cloud.google.com/scheduler/docs/configuring/cron-job-schedules",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Amazon EventBridge cron format (w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: cronScheduleAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (g *gcpSchedule) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gcp_schedule"
+}
+
+// Run implements function.Function.
+func (g *gcpSchedule) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	runCronSchedule(ctx, req, resp, "Google Cloud Scheduler")
+}
+
+var _ function.Function = (*gcpSchedule)(nil)
+
+func NewGcpScheduleFunction() function.Function {
+	return &gcpSchedule{}
+}
+
+type kubernetesSchedule struct{}
+
+// Definition implements function.Function.
+func (k *kubernetesSchedule) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to Kubernetes CronJob schedule with timezone",
+		Description: "Convert an Amazon EventBridge cron expression to Kubernetes CronJob `schedule` and `timeZone`. Constructs Kubernetes cannot express(`L`, `W`, `#` and year) are reported as errors. If location is null, UTC is used. See: https://kubernetes.io/docs/concepts/workloads/controllers/cron-jobs/#schedule-syntax",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Amazon EventBridge cron format (w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: cronScheduleAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (k *kubernetesSchedule) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kubernetes_schedule"
+}
+
+// Run implements function.Function.
+func (k *kubernetesSchedule) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	runCronSchedule(ctx, req, resp, "Kubernetes CronJob")
+}
+
+var _ function.Function = (*kubernetesSchedule)(nil)
+
+func NewKubernetesScheduleFunction() function.Function {
+	return &kubernetesSchedule{}
+}
+
+type azureNcrontab struct{}
+
+// Definition implements function.Function.
+func (a *azureNcrontab) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to Azure NCRONTAB expression string",
+		Description: "Convert an Amazon EventBridge cron expression to Azure NCRONTAB expression string with 6 fields(`{second} {minute} {hour} {day} {month} {day-of-week}`). Constructs NCRONTAB cannot express(`L`, `W`, `#` and year) are reported as errors. See: https://learn.microsoft.com/en-us/azure/azure-functions/functions-bindings-timer#ncrontab-expressions",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Amazon EventBridge cron format (w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (a *azureNcrontab) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "azure_ncrontab"
+}
+
+// Run implements function.Function.
+func (a *azureNcrontab) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+
+	expr, err := cronplan.Parse(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	fs, err := unixCronFields(expr, "Azure NCRONTAB")
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	output := strings.Join(append([]string{"0"}, fs...), " ")
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*azureNcrontab)(nil)
+
+func NewAzureNcrontabFunction() function.Function {
+	return &azureNcrontab{}
+}
@@ -0,0 +1,137 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGcpScheduleFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "daily" {
+					value = provider::timeconv::gcp_schedule("0 12 * * ? *", "Asia/Tokyo")
+				}
+				output "business_hours" {
+					value = provider::timeconv::gcp_schedule("0/15 9-17 ? * MON-FRI *", null)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("daily", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"schedule":  knownvalue.StringExact("0 12 * * *"),
+						"time_zone": knownvalue.StringExact("Asia/Tokyo"),
+					})),
+					statecheck.ExpectKnownOutputValue("business_hours", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"schedule":  knownvalue.StringExact("*/15 9-17 * * 1-5"),
+						"time_zone": knownvalue.StringExact("UTC"),
+					})),
+				},
+			},
+			{
+				Config: `output "last_day" {
+					value = provider::timeconv::gcp_schedule("0 12 L * ? *", null)
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+day-of-month\s+"L"`),
+			},
+			{
+				Config: `output "year" {
+					value = provider::timeconv::gcp_schedule("0 12 1 * ? 2025", null)
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+year\s+"2025"`),
+			},
+		},
+	})
+}
+
+func TestKubernetesScheduleFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "friday" {
+					value = provider::timeconv::kubernetes_schedule("0 12 ? * FRI *", "Europe/Berlin")
+				}
+				output "weekend" {
+					value = provider::timeconv::kubernetes_schedule("0 12 ? * FRI-SUN *", "Europe/Berlin")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("friday", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"schedule":  knownvalue.StringExact("0 12 * * 5"),
+						"time_zone": knownvalue.StringExact("Europe/Berlin"),
+					})),
+					statecheck.ExpectKnownOutputValue("weekend", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"schedule":  knownvalue.StringExact("0 12 * * 0,5-6"),
+						"time_zone": knownvalue.StringExact("Europe/Berlin"),
+					})),
+				},
+			},
+			{
+				Config: `output "nth_weekday" {
+					value = provider::timeconv::kubernetes_schedule("0 12 ? * 2#1 *", null)
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+day-of-week\s+"MON#1"`),
+			},
+			{
+				Config: `output "local_location" {
+					value = provider::timeconv::kubernetes_schedule("0 12 * * ? *", "Local")
+				}`,
+				ExpectError: regexp.MustCompile(`must\s+be\s+an\s+IANA\s+timezone\s+name`),
+			},
+		},
+	})
+}
+
+func TestAzureNcrontabFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `output "azure_ncrontab" {
+					value = provider::timeconv::azure_ncrontab("30 2 1,15 * ? *")
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("azure_ncrontab", knownvalue.StringExact("0 30 2 1,15 * *")),
+				},
+			},
+			{
+				Config: `output "nearest_weekday" {
+					value = provider::timeconv::azure_ncrontab("30 2 15W * ? *")
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+day-of-month\s+"15W"`),
+			},
+			{
+				Config: `output "invalid_input" {
+					value = provider::timeconv::azure_ncrontab("0 12 * * * *")
+				}`,
+				ExpectError: regexp.MustCompile(`failed:`),
+			},
+		},
+	})
+}
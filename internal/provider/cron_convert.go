@@ -0,0 +1,138 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+// loadScheduleLocation loads the IANA timezone location used by schedulers.
+// Null means UTC, and "Local" is rejected because schedulers cannot resolve it.
+func loadScheduleLocation(location types.String) (*time.Location, error) {
+	if location.IsNull() {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(location.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if loc == time.Local {
+		return nil, fmt.Errorf("location must be an IANA timezone name: %s", location.ValueString())
+	}
+	return loc, nil
+}
+
+// cronFieldValues returns the values between lo and hi matched by match.
+func cronFieldValues(lo, hi int, match func(int) bool) []int {
+	values := []int{}
+	for v := lo; v <= hi; v++ {
+		if match(v) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// formatCronField renders values between lo and hi as a compact cron field
+// which uses only numbers, "*", "-", "," and "/".
+func formatCronField(values []int, lo, hi int) string {
+	if len(values) == hi-lo+1 {
+		return "*"
+	}
+	if len(values) >= 3 {
+		step := values[1] - values[0]
+		progression := step > 1
+		for i := 2; progression && i < len(values); i++ {
+			progression = values[i]-values[i-1] == step
+		}
+		if progression && values[len(values)-1]+step > hi {
+			if values[0] == lo {
+				return fmt.Sprintf("*/%d", step)
+			}
+			return fmt.Sprintf("%d-%d/%d", values[0], values[len(values)-1], step)
+		}
+	}
+	ss := []string{}
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j > i {
+			ss = append(ss, fmt.Sprintf("%d-%d", values[i], values[j]))
+		} else {
+			ss = append(ss, strconv.Itoa(values[i]))
+		}
+		i = j + 1
+	}
+	return strings.Join(ss, ",")
+}
+
+// cronYears returns the years matched by expr within the range EventBridge
+// accepts.
+func cronYears(expr *cronplan.Expression) []int {
+	return cronFieldValues(1970, 2199, func(v int) bool {
+		return expr.Year.Match(time.Date(v, time.January, 1, 0, 0, 0, 0, time.UTC))
+	})
+}
+
+// unsupportedCronConstructs reports the constructs of expr which cannot be
+// expressed by the standard 5 fields of Unix cron used by target.
+func unsupportedCronConstructs(expr *cronplan.Expression, target string) error {
+	var errs []error
+	if !expr.DayOfMonth.Any {
+		for _, e := range expr.DayOfMonth.Exps {
+			if e.NearestWeekday != nil || e.LastWeekday != nil || e.Last != nil {
+				errs = append(errs, fmt.Errorf("%s does not support day-of-month %q", target, e.String()))
+			}
+		}
+	}
+	if !expr.DayOfWeek.Any {
+		for _, e := range expr.DayOfWeek.Exps {
+			if e.Nth != nil || e.Last != nil {
+				errs = append(errs, fmt.Errorf("%s does not support day-of-week %q", target, e.String()))
+			}
+		}
+	}
+	if len(cronYears(expr)) != 2199-1970+1 {
+		errs = append(errs, fmt.Errorf("%s does not support year %q", target, expr.Year.String()))
+	}
+	return errors.Join(errs...)
+}
+
+// unixCronFields converts expr into the standard 5 fields of Unix cron
+// (minute, hour, day-of-month, month and day-of-week from 0 as Sunday).
+func unixCronFields(expr *cronplan.Expression, target string) ([]string, error) {
+	if err := unsupportedCronConstructs(expr, target); err != nil {
+		return nil, err
+	}
+	fields := []struct {
+		name   string
+		lo, hi int
+		match  func(int) bool
+	}{
+		{"minute", 0, 59, func(v int) bool { return expr.Minute.Match(time.Date(2000, 1, 1, 0, v, 0, 0, time.UTC)) }},
+		{"hour", 0, 23, func(v int) bool { return expr.Hour.Match(time.Date(2000, 1, 1, v, 0, 0, 0, time.UTC)) }},
+		{"day-of-month", 1, 31, func(v int) bool { return expr.DayOfMonth.Match(time.Date(2000, 1, v, 0, 0, 0, 0, time.UTC)) }},
+		{"month", 1, 12, func(v int) bool { return expr.Month.Match(time.Date(2000, time.Month(v), 1, 0, 0, 0, 0, time.UTC)) }},
+		// 2000-01-02 is Sunday.
+		{"day-of-week", 0, 6, func(v int) bool { return expr.DayOfWeek.Match(time.Date(2000, 1, 2+v, 0, 0, 0, 0, time.UTC)) }},
+	}
+	fs := make([]string, 0, len(fields))
+	for _, f := range fields {
+		values := cronFieldValues(f.lo, f.hi, f.match)
+		if len(values) == 0 {
+			return nil, fmt.Errorf("%s field never matches: %s", f.name, expr.String())
+		}
+		fs = append(fs, formatCronField(values, f.lo, f.hi))
+	}
+	return fs, nil
+}
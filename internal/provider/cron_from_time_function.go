@@ -0,0 +1,117 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// cronRecurrences are the recurrences supported by recurringCron.
+var cronRecurrences = []string{"daily", "weekly", "monthly", "yearly"}
+
+var cronFromTimeAttrTypes = map[string]attr.Type{
+	"aws_cron":  types.StringType,
+	"unix_cron": types.StringType,
+	"time_zone": types.StringType,
+}
+
+// recurringCron returns the EventBridge and Unix cron expressions firing at
+// the same wall clock time as t every recurrence.
+func recurringCron(t time.Time, recurrence string) (string, string, error) {
+	m, h, d, mon := t.Minute(), t.Hour(), t.Day(), int(t.Month())
+	switch recurrence {
+	case "daily":
+		return fmt.Sprintf("%d %d * * ? *", m, h), fmt.Sprintf("%d %d * * *", m, h), nil
+	case "weekly":
+		return fmt.Sprintf("%d %d ? * %s *", m, h, strings.ToUpper(t.Weekday().String()[:3])), fmt.Sprintf("%d %d * * %d", m, h, t.Weekday()), nil
+	case "monthly":
+		return fmt.Sprintf("%d %d %d * ? *", m, h, d), fmt.Sprintf("%d %d %d * *", m, h, d), nil
+	case "yearly":
+		return fmt.Sprintf("%d %d %d %d ? *", m, h, d, mon), fmt.Sprintf("%d %d %d %d *", m, h, d, mon), nil
+	}
+	return "", "", fmt.Errorf("recurrence must be one of %s: %q", strings.Join(cronRecurrences, ", "), recurrence)
+}
+
+type cronFromTime struct{}
+
+// Definition implements function.Function.
+func (c *cronFromTime) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert time to recurring cron expressions",
+		Description: "Convert time to Amazon EventBridge and Unix cron expressions which fire at the same wall clock time in location every day, week, month or year. Monthly schedules on day 29-31 are skipped in shorter months. If location is null, UTC is used.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in RFC3339 format",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "recurrence",
+				Description:    "Recurrence. `daily`, `weekly`, `monthly` or `yearly`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: cronFromTimeAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (c *cronFromTime) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_from_time"
+}
+
+// Run implements function.Function.
+func (c *cronFromTime) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input timetypes.RFC3339
+	var recurrence string
+	var location types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &recurrence, &location))
+	if resp.Error != nil {
+		return
+	}
+
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+	t, _ := input.ValueRFC3339Time()
+	awsCron, unixCron, err := recurringCron(t.In(loc), recurrence)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	output, diags := types.ObjectValue(cronFromTimeAttrTypes, map[string]attr.Value{
+		"aws_cron":  types.StringValue(awsCron),
+		"unix_cron": types.StringValue(unixCron),
+		"time_zone": types.StringValue(loc.String()),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*cronFromTime)(nil)
+
+func NewCronFromTimeFunction() function.Function {
+	return &cronFromTime{}
+}
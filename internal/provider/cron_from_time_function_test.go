@@ -0,0 +1,73 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCronFromTimeFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "daily" {
+					value = provider::timeconv::cron_from_time("2024-03-15T07:30:00Z", "daily", "Asia/Tokyo")
+				}
+				output "weekly" {
+					value = provider::timeconv::cron_from_time("2024-03-15T07:30:00Z", "weekly", "Asia/Tokyo")
+				}
+				output "monthly" {
+					value = provider::timeconv::cron_from_time("2024-03-15T07:30:00Z", "monthly", null)
+				}
+				output "yearly" {
+					value = provider::timeconv::cron_from_time("2024-03-15T07:30:00Z", "yearly", "America/New_York")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("daily", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"aws_cron":  knownvalue.StringExact("30 16 * * ? *"),
+						"unix_cron": knownvalue.StringExact("30 16 * * *"),
+						"time_zone": knownvalue.StringExact("Asia/Tokyo"),
+					})),
+					statecheck.ExpectKnownOutputValue("weekly", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"aws_cron":  knownvalue.StringExact("30 16 ? * FRI *"),
+						"unix_cron": knownvalue.StringExact("30 16 * * 5"),
+						"time_zone": knownvalue.StringExact("Asia/Tokyo"),
+					})),
+					statecheck.ExpectKnownOutputValue("monthly", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"aws_cron":  knownvalue.StringExact("30 7 15 * ? *"),
+						"unix_cron": knownvalue.StringExact("30 7 15 * *"),
+						"time_zone": knownvalue.StringExact("UTC"),
+					})),
+					statecheck.ExpectKnownOutputValue("yearly", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"aws_cron":  knownvalue.StringExact("30 3 15 3 ? *"),
+						"unix_cron": knownvalue.StringExact("30 3 15 3 *"),
+						"time_zone": knownvalue.StringExact("America/New_York"),
+					})),
+				},
+			},
+			{
+				Config: `
+				output "invalid_recurrence" {
+					value = provider::timeconv::cron_from_time("2024-03-15T07:30:00Z", "hourly", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`recurrence\s+must\s+be\s+one\s+of\s+daily,\s+weekly,\s+monthly,\s+yearly`),
+			},
+		},
+	})
+}
@@ -17,7 +17,7 @@ type awsCron struct{}
 func (a *awsCron) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to AWS cron expression string(w/o \"cron(...)\")",
-		Description: "Convert to AWS cron expression string(w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+		Description: "Convert to AWS cron expression string(w/o \"cron(...)\"). An expression which never fires is reported as an error. See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
 
 		Parameters: []function.Parameter{
 			function.StringParameter{
@@ -46,6 +46,10 @@ func (a *awsCron) Run(ctx context.Context, req function.RunRequest, resp *functi
 		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
 		return
 	}
+	if cronNeverFires(expr) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("never fires: "+expr.String()))
+		return
+	}
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, expr.String()))
 }
 
@@ -44,6 +44,12 @@ func TestAwsCronFunction(t *testing.T) {
 				}`,
 				ExpectError: regexp.MustCompile(`failed:`),
 			},
+			{
+				Config: `output "never_fires" {
+					value = provider::timeconv::aws_cron("30 2 31 2 ? *")
+				}`,
+				ExpectError: regexp.MustCompile(`never\s+fires`),
+			},
 		},
 	})
 }
@@ -0,0 +1,177 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+// cronEpoch is the earliest time EventBridge cron expressions can fire.
+var cronEpoch = time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// cronNeverFires reports whether expr never fires in any year.
+func cronNeverFires(expr *cronplan.Expression) bool {
+	return expr.Next(cronEpoch).IsZero()
+}
+
+// cronWarnings returns the warnings for suspicious but valid expr evaluated
+// in loc from ref.
+func cronWarnings(expr *cronplan.Expression, loc *time.Location, ref time.Time) []string {
+	warnings := []string{}
+	if cronNeverFires(expr) {
+		return append(warnings, fmt.Sprintf("never fires: %s", expr.String()))
+	}
+	ref = ref.In(loc)
+	if expr.Next(ref).IsZero() {
+		warnings = append(warnings, fmt.Sprintf("never fires after %s: year %s is in the past", ref.Format(time.RFC3339), expr.Year.String()))
+	}
+
+	days := cronFieldValues(1, 31, func(v int) bool {
+		return expr.DayOfMonth.Match(time.Date(2000, time.January, v, 0, 0, 0, 0, time.UTC))
+	})
+	if !expr.DayOfMonth.Any && len(days) != 31 {
+		months := cronFieldValues(1, 12, func(v int) bool {
+			return expr.Month.Match(time.Date(2000, time.Month(v), 1, 0, 0, 0, 0, time.UTC))
+		})
+		for _, day := range days {
+			if day < 29 {
+				continue
+			}
+			skipped := []string{}
+			for _, month := range months {
+				// 2001 is not a leap year, so February 29th is reported too.
+				if time.Date(2001, time.Month(month), day, 0, 0, 0, 0, time.UTC).Day() != day {
+					skipped = append(skipped, time.Month(month).String()[:3])
+				}
+			}
+			if len(skipped) > 0 {
+				warnings = append(warnings, fmt.Sprintf("day-of-month %d is skipped in %s", day, strings.Join(skipped, ", ")))
+			}
+		}
+	}
+
+	for _, tr := range locationTransitions(loc, ref, ref.AddDate(1, 0, 0)) {
+		// Wall clock times from start for width are skipped(gap) or
+		// repeated(overlap).
+		start, width, kind := tr.at.Add(time.Duration(tr.before)*time.Second), time.Duration(tr.after-tr.before)*time.Second, "does not exist"
+		if width < 0 {
+			start, width, kind = tr.at.Add(time.Duration(tr.after)*time.Second), -width, "occurs twice"
+		}
+		start = start.UTC().Truncate(time.Minute)
+		for wall := start; wall.Before(start.Add(width)); wall = wall.Add(time.Minute) {
+			if expr.Match(wall) {
+				warnings = append(warnings, fmt.Sprintf("fires at %s which %s in %s due to the change of UTC offset", wall.Format("2006-01-02T15:04"), kind, loc))
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+type locationTransition struct {
+	at            time.Time
+	before, after int
+}
+
+// locationTransitions returns the changes of UTC offset in loc between from
+// and to.
+func locationTransitions(loc *time.Location, from, to time.Time) []locationTransition {
+	transitions := []locationTransition{}
+	_, prev := from.In(loc).Zone()
+	for t := from; t.Before(to); t = t.Add(time.Hour) {
+		next := t.Add(time.Hour)
+		_, offset := next.In(loc).Zone()
+		if offset == prev {
+			continue
+		}
+		lo, hi := t, next
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if _, o := mid.In(loc).Zone(); o == prev {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		transitions = append(transitions, locationTransition{at: hi, before: prev, after: offset})
+		prev = offset
+	}
+	return transitions
+}
+
+type cronLint struct{}
+
+// Definition implements function.Function.
+func (c *cronLint) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Lint AWS cron expression",
+		Description: "Return warnings for a valid but suspicious Amazon EventBridge cron expression: never fires, fires only in the past, day-of-month skipped in short months, and fire times falling into the gap or overlap of UTC offset changes(DST) in location within a year from reference. If location is null, UTC is used.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Amazon EventBridge cron format (w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "reference",
+				Description:    "Reference time string in RFC3339 format which the warnings are evaluated from. like `plantimestamp()`",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (c *cronLint) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_lint"
+}
+
+// Run implements function.Function.
+func (c *cronLint) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var location types.String
+	var reference timetypes.RFC3339
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &location, &reference))
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := cronplan.Parse(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	ref, _ := reference.ValueRFC3339Time()
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, cronWarnings(expr, loc, ref)))
+}
+
+var _ function.Function = (*cronLint)(nil)
+
+func NewCronLintFunction() function.Function {
+	return &cronLint{}
+}
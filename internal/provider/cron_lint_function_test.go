@@ -0,0 +1,79 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCronLintFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "no_warnings" {
+					value = provider::timeconv::cron_lint("0 12 * * ? *", "Asia/Tokyo", "2026-10-18T00:00:00Z")
+				}
+				output "never_fires" {
+					value = provider::timeconv::cron_lint("30 2 31 2 ? *", null, "2026-10-18T00:00:00Z")
+				}
+				output "skipped_days" {
+					value = provider::timeconv::cron_lint("30 2 31 * ? *", null, "2026-10-18T00:00:00Z")
+				}
+				output "past_years" {
+					value = provider::timeconv::cron_lint("30 2 * * ? 2020-2022", null, "2026-10-18T00:00:00Z")
+				}
+				output "dst_gap" {
+					value = provider::timeconv::cron_lint("30 2 * * ? *", "America/New_York", "2026-10-18T00:00:00Z")
+				}
+				output "dst_overlap" {
+					value = provider::timeconv::cron_lint("30 1 * * ? *", "America/New_York", "2026-10-18T00:00:00Z")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("no_warnings", knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownOutputValue("never_fires", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("never fires: 30 2 31 FEB ? *"),
+					})),
+					statecheck.ExpectKnownOutputValue("skipped_days", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("day-of-month 31 is skipped in Feb, Apr, Jun, Sep, Nov"),
+					})),
+					statecheck.ExpectKnownOutputValue("past_years", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringRegexp(regexp.MustCompile(`^never fires after 2026-10-18T00:00:00Z: year 2020-2022 is in the past$`)),
+					})),
+					statecheck.ExpectKnownOutputValue("dst_gap", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringRegexp(regexp.MustCompile(`^fires at 2027-03-14T02:30 which does not exist in America/New_York`)),
+					})),
+					statecheck.ExpectKnownOutputValue("dst_overlap", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringRegexp(regexp.MustCompile(`^fires at 2026-11-01T01:30 which occurs twice in America/New_York`)),
+					})),
+				},
+			},
+			{
+				Config: `output "invalid_input" {
+					value = provider::timeconv::cron_lint("0 12 * * * *", null, "2026-10-18T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`failed:`),
+			},
+			{
+				Config: `output "null_reference" {
+					value = provider::timeconv::cron_lint("0 12 * * ? *", null, null)
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid\s+function\s+argument`),
+			},
+		},
+	})
+}
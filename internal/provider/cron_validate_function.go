@@ -0,0 +1,252 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+// cronProfile describes the schedule expressions a service accepts.
+type cronProfile struct {
+	description string
+	// unix is true if the service accepts Unix cron instead of EventBridge
+	// cron.
+	unix bool
+	// rateUnits are the units of rate expressions, or nil if the service
+	// does not accept rate expressions.
+	rateUnits []string
+	// at is true if the service accepts one-time at expressions.
+	at bool
+	// minInterval is the minimum interval between runs.
+	minInterval time.Duration
+	// offset is true if the service accepts a schedule offset in days after
+	// the fire times of cron expressions.
+	offset bool
+}
+
+var (
+	cronProfiles = map[string]cronProfile{
+		"eventbridge": {description: "EventBridge rules", rateUnits: []string{"minute", "hour", "day"}, minInterval: time.Minute},
+		"scheduler":   {description: "EventBridge Scheduler", rateUnits: []string{"minute", "hour", "day"}, at: true, minInterval: time.Minute},
+		"autoscaling": {description: "Auto Scaling scheduled actions", unix: true, minInterval: time.Minute},
+		"glue":        {description: "Glue triggers", minInterval: 5 * time.Minute},
+		"dlm":         {description: "Data Lifecycle Manager", minInterval: time.Hour},
+		"backup":      {description: "Backup plans", minInterval: time.Hour},
+		"ssm":         {description: "Systems Manager maintenance windows", rateUnits: []string{"hour", "day"}, minInterval: time.Hour, offset: true},
+	}
+	cronWrapper = regexp.MustCompile(`^(cron|rate|at)\((.*)\)$`)
+	rateBody    = regexp.MustCompile(`^(\d+) ([a-z]+)$`)
+)
+
+const (
+	minScheduleOffset = 1
+	maxScheduleOffset = 6
+)
+
+// cronProfileNames returns the sorted names of cronProfiles.
+func cronProfileNames() []string {
+	names := make([]string, 0, len(cronProfiles))
+	for name := range cronProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// cronMinInterval returns the minimum interval between the runs of expr. Days
+// are assumed to be consecutive, so it can be shorter than the actual one.
+func cronMinInterval(expr *cronplan.Expression) time.Duration {
+	times := []int{}
+	for h := 0; h < 24; h++ {
+		for m := 0; m < 60; m++ {
+			t := time.Date(2000, 1, 1, h, m, 0, 0, time.UTC)
+			if expr.Hour.Match(t) && expr.Minute.Match(t) {
+				times = append(times, h*60+m)
+			}
+		}
+	}
+	interval := 24 * 60
+	for i := range times {
+		gap := 24*60 + times[0] - times[i]
+		if i+1 < len(times) {
+			gap = times[i+1] - times[i]
+		}
+		interval = min(interval, gap)
+	}
+	return time.Duration(interval) * time.Minute
+}
+
+// validateScheduleOffset validates the schedule offset in days of input
+// against profile.
+func validateScheduleOffset(input string, offset int64, profile cronProfile) error {
+	if !profile.offset {
+		return fmt.Errorf("%s does not support schedule offsets", profile.description)
+	}
+	if m := cronWrapper.FindStringSubmatch(strings.TrimSpace(input)); m == nil || m[1] != "cron" {
+		return fmt.Errorf("schedule offset requires a cron(...) expression: %q", input)
+	}
+	if offset < minScheduleOffset || offset > maxScheduleOffset {
+		return fmt.Errorf("schedule offset must be between %d and %d days: %d", minScheduleOffset, maxScheduleOffset, offset)
+	}
+	return nil
+}
+
+// validateSchedule validates input against profile and returns the
+// normalised expression.
+func validateSchedule(input string, profile cronProfile) (string, error) {
+	input = strings.TrimSpace(input)
+	kind, body, wrapped := "cron", input, false
+	if m := cronWrapper.FindStringSubmatch(input); m != nil {
+		kind, body, wrapped = m[1], m[2], true
+	}
+
+	switch kind {
+	case "rate":
+		if profile.rateUnits == nil {
+			return "", fmt.Errorf("%s does not support rate expressions", profile.description)
+		}
+		m := rateBody.FindStringSubmatch(body)
+		if m == nil {
+			return "", fmt.Errorf("invalid rate expression %q", input)
+		}
+		value, err := strconv.Atoi(m[1])
+		if err != nil || value < 1 {
+			return "", fmt.Errorf("rate value must be a positive integer: %q", input)
+		}
+		unit := strings.TrimSuffix(m[2], "s")
+		if (value == 1) != (unit == m[2]) {
+			return "", fmt.Errorf("rate unit must be singular for 1 and plural otherwise: %q", input)
+		}
+		units := map[string]time.Duration{"minute": time.Minute, "hour": time.Hour, "day": 24 * time.Hour}
+		supported := false
+		for _, u := range profile.rateUnits {
+			supported = supported || u == unit
+		}
+		if !supported {
+			return "", fmt.Errorf("%s does not support rate unit %q", profile.description, m[2])
+		}
+		if d := time.Duration(value) * units[unit]; d < profile.minInterval {
+			return "", fmt.Errorf("%s requires at least %s between runs: %q", profile.description, formatWindowDuration(profile.minInterval), input)
+		}
+		return fmt.Sprintf("rate(%d %s)", value, m[2]), nil
+	case "at":
+		if !profile.at {
+			return "", fmt.Errorf("%s does not support at expressions", profile.description)
+		}
+		t, err := time.Parse("2006-01-02T15:04:05", body)
+		if err != nil {
+			return "", fmt.Errorf("invalid at expression %q: %w", input, err)
+		}
+		return fmt.Sprintf("at(%s)", t.Format("2006-01-02T15:04:05")), nil
+	}
+
+	var expr *cronplan.Expression
+	var err error
+	if profile.unix {
+		expr, err = parseAwsOrUnixCron(body)
+	} else {
+		expr, err = cronplan.Parse(body)
+	}
+	if err != nil {
+		return "", err
+	}
+	if cronNeverFires(expr) {
+		return "", fmt.Errorf("never fires: %s", expr.String())
+	}
+	if d := cronMinInterval(expr); d < profile.minInterval {
+		return "", fmt.Errorf("%s requires at least %s between runs, but %q runs every %s", profile.description, formatWindowDuration(profile.minInterval), input, formatWindowDuration(d))
+	}
+	if profile.unix {
+		// Unix cron is never wrapped in "cron(...)".
+		fs, err := unixCronFields(expr, profile.description)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fs, " "), nil
+	}
+	output := expr.String()
+	if wrapped {
+		output = fmt.Sprintf("cron(%s)", output)
+	}
+	return output, nil
+}
+
+type cronValidate struct{}
+
+// Definition implements function.Function.
+func (c *cronValidate) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Validate schedule expression for AWS service",
+		Description: fmt.Sprintf("Validate schedule expression(`cron(...)`, bare cron, `rate(...)` or `at(...)`) against the dialect of the AWS service named by profile, and return the normalized expression. Profiles are `%s`. `autoscaling` accepts Unix cron and returns it without `cron(...)`, `glue` requires 5 minutes, and `dlm`, `backup` and `ssm` require 1 hour between runs. `ssm` also accepts offset with a `cron(...)` expression, like `schedule_offset` of `aws_ssm_maintenance_window`.", strings.Join(cronProfileNames(), "`, `")),
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input schedule expression",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "profile",
+				Description:    "Profile name of the AWS service",
+				AllowNullValue: false,
+			},
+			function.Int64Parameter{
+				Name:           "offset",
+				Description:    "Schedule offset in days from 1 to 6, or null",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (c *cronValidate) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_validate"
+}
+
+// Run implements function.Function.
+func (c *cronValidate) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, name string
+	var offset types.Int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &name, &offset))
+	if resp.Error != nil {
+		return
+	}
+
+	profile, ok := cronProfiles[name]
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("unknown profile %q, must be one of %s", name, strings.Join(cronProfileNames(), ", "))))
+		return
+	}
+	if !offset.IsNull() {
+		if err := validateScheduleOffset(input, offset.ValueInt64(), profile); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+			return
+		}
+	}
+	output, err := validateSchedule(input, profile)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*cronValidate)(nil)
+
+func NewCronValidateFunction() function.Function {
+	return &cronValidate{}
+}
@@ -0,0 +1,117 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCronValidateFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "eventbridge" {
+					value = provider::timeconv::cron_validate("cron(0/15 * * * ? *)", "eventbridge", null)
+				}
+				output "backup" {
+					value = provider::timeconv::cron_validate("0 5,17 ? * * *", "backup", null)
+				}
+				output "autoscaling" {
+					value = provider::timeconv::cron_validate("0 9 ? * MON-FRI *", "autoscaling", null)
+				}
+				output "ssm" {
+					value = provider::timeconv::cron_validate("rate(2 hours)", "ssm", null)
+				}
+				output "scheduler" {
+					value = provider::timeconv::cron_validate("at(2030-01-01T00:00:00)", "scheduler", null)
+				}
+				output "autoscaling_wrapped" {
+					value = provider::timeconv::cron_validate("cron(0 9 ? * MON-FRI *)", "autoscaling", null)
+				}
+				output "ssm_cron_offset" {
+					value = provider::timeconv::cron_validate("cron(30 23 ? * TUE#3 *)", "ssm", 6)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("eventbridge", knownvalue.StringExact("cron(0/15 * * * ? *)")),
+					statecheck.ExpectKnownOutputValue("backup", knownvalue.StringExact("0 5,17 ? * * *")),
+					statecheck.ExpectKnownOutputValue("autoscaling", knownvalue.StringExact("0 9 * * 1-5")),
+					statecheck.ExpectKnownOutputValue("ssm", knownvalue.StringExact("rate(2 hours)")),
+					statecheck.ExpectKnownOutputValue("scheduler", knownvalue.StringExact("at(2030-01-01T00:00:00)")),
+					statecheck.ExpectKnownOutputValue("autoscaling_wrapped", knownvalue.StringExact("0 9 * * 1-5")),
+					statecheck.ExpectKnownOutputValue("ssm_cron_offset", knownvalue.StringExact("cron(30 23 ? * TUE#3 *)")),
+				},
+			},
+			{
+				Config: `
+				output "sub_hourly" {
+					value = provider::timeconv::cron_validate("0/15 * * * ? *", "dlm", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Data\s+Lifecycle\s+Manager\s+requires\s+at\s+least\s+1h\s+between\s+runs`),
+			},
+			{
+				Config: `
+				output "rate_unit" {
+					value = provider::timeconv::cron_validate("rate(30 minutes)", "ssm", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+rate\s+unit\s+"minutes"`),
+			},
+			{
+				Config: `
+				output "offset_range" {
+					value = provider::timeconv::cron_validate("cron(30 23 ? * TUE#3 *)", "ssm", 7)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`schedule\s+offset\s+must\s+be\s+between\s+1\s+and\s+6\s+days`),
+			},
+			{
+				Config: `
+				output "offset_rate" {
+					value = provider::timeconv::cron_validate("rate(1 day)", "ssm", 2)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`schedule\s+offset\s+requires\s+a\s+cron\(\.\.\.\)\s+expression`),
+			},
+			{
+				Config: `
+				output "offset_profile" {
+					value = provider::timeconv::cron_validate("cron(30 23 ? * TUE#3 *)", "eventbridge", 2)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+schedule\s+offsets`),
+			},
+			{
+				Config: `
+				output "unsupported" {
+					value = provider::timeconv::cron_validate("0 9 L * ? *", "autoscaling", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+day-of-month\s+"L"`),
+			},
+			{
+				Config: `
+				output "unknown_profile" {
+					value = provider::timeconv::cron_validate("0 9 * * ? *", "lambda", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown\s+profile\s+"lambda"`),
+			},
+		},
+	})
+}
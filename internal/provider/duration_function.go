@@ -0,0 +1,351 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// durationUnit is a unit of duration strings.
+type durationUnit struct {
+	name     string
+	duration time.Duration
+}
+
+// durationStyle is a duration string format of an ecosystem.
+type durationStyle struct {
+	description string
+	parse       func(s string) (time.Duration, error)
+	format      func(d time.Duration) (string, error)
+	// signed is true if the style accepts negative durations.
+	signed bool
+}
+
+const dayDuration = 24 * time.Hour
+
+var (
+	durationStyles = map[string]durationStyle{
+		"go":         {description: "Go", parse: time.ParseDuration, format: formatGoDuration, signed: true},
+		"kubernetes": {description: "Kubernetes", parse: time.ParseDuration, format: formatGoDuration, signed: true},
+		"iso8601":    {description: "ISO 8601", parse: parseFixedISO8601Duration, format: formatISO8601DurationString},
+		"prometheus": {description: "Prometheus", parse: parsePrometheusDuration, format: formatPrometheusDuration},
+		"systemd":    {description: "systemd", parse: parseSystemdTimespan, format: formatSystemdTimespan},
+		"cloudwatch": {description: "CloudWatch", parse: parseSecondsDuration, format: formatSecondsDuration},
+		"aws_rate":   {description: "AWS rate expression", parse: parseRateDuration, format: formatRateDuration},
+	}
+	prometheusDurationPattern = regexp.MustCompile(`^(?:(\d+)y)?(?:(\d+)w)?(?:(\d+)d)?(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s)?(?:(\d+)ms)?$`)
+	prometheusUnits           = []durationUnit{
+		{"y", 365 * dayDuration}, {"w", 7 * dayDuration}, {"d", dayDuration}, {"h", time.Hour}, {"m", time.Minute}, {"s", time.Second}, {"ms", time.Millisecond},
+	}
+	systemdTimespanToken = regexp.MustCompile(`^\s*(\d+(?:\.\d+)?)\s*([a-zA-Zµ]*)`)
+	systemdUnits         = map[string]time.Duration{
+		"usec": time.Microsecond, "us": time.Microsecond, "µs": time.Microsecond,
+		"msec": time.Millisecond, "ms": time.Millisecond,
+		"seconds": time.Second, "second": time.Second, "sec": time.Second, "s": time.Second, "": time.Second,
+		"minutes": time.Minute, "minute": time.Minute, "min": time.Minute, "m": time.Minute,
+		"hours": time.Hour, "hour": time.Hour, "hr": time.Hour, "h": time.Hour,
+		"days": dayDuration, "day": dayDuration, "d": dayDuration,
+		"weeks": 7 * dayDuration, "week": 7 * dayDuration, "w": 7 * dayDuration,
+		"months": 2629800 * time.Second, "month": 2629800 * time.Second, "M": 2629800 * time.Second,
+		"years": 31557600 * time.Second, "year": 31557600 * time.Second, "y": 31557600 * time.Second,
+	}
+	systemdFormatUnits = []durationUnit{
+		{"w", 7 * dayDuration}, {"d", dayDuration}, {"h", time.Hour}, {"min", time.Minute}, {"s", time.Second}, {"ms", time.Millisecond}, {"us", time.Microsecond},
+	}
+	rateUnits = []durationUnit{{"day", dayDuration}, {"hour", time.Hour}, {"minute", time.Minute}}
+)
+
+// durationStyleNames returns the sorted names of durationStyles.
+func durationStyleNames() []string {
+	names := make([]string, 0, len(durationStyles))
+	for name := range durationStyles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadDurationStyle returns the durationStyle named name.
+func loadDurationStyle(name string) (durationStyle, error) {
+	style, ok := durationStyles[name]
+	if !ok {
+		return durationStyle{}, fmt.Errorf("unknown duration style %q, must be one of `%s`", name, strings.Join(durationStyleNames(), "`, `"))
+	}
+	return style, nil
+}
+
+// formatUnits formats d as the sequence of units from the largest joined by
+// sep, or zero if d is 0.
+func formatUnits(d time.Duration, units []durationUnit, sep, zero string) string {
+	parts := []string{}
+	for _, u := range units {
+		if n := d / u.duration; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, u.name))
+			d -= n * u.duration
+		}
+	}
+	if len(parts) == 0 {
+		return zero
+	}
+	return strings.Join(parts, sep)
+}
+
+func formatGoDuration(d time.Duration) (string, error) {
+	return d.String(), nil
+}
+
+// parseFixedISO8601Duration parses s as an ISO 8601 duration of fixed length,
+// so days are 24 hours and weeks are 7 days.
+func parseFixedISO8601Duration(s string) (time.Duration, error) {
+	d, err := parseISO8601Duration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d.years != 0 || d.months != 0 {
+		return 0, fmt.Errorf("years and months have no fixed length: %q", s)
+	}
+	return time.Duration(d.days)*dayDuration + d.elapsed, nil
+}
+
+func formatISO8601DurationString(d time.Duration) (string, error) {
+	return formatISO8601Duration(d), nil
+}
+
+// parsePrometheusDuration parses s like `1w`, `90m` or `1h30m`.
+func parsePrometheusDuration(s string) (time.Duration, error) {
+	m := prometheusDurationPattern.FindStringSubmatch(s)
+	if m == nil || s == "" {
+		return 0, fmt.Errorf("invalid Prometheus duration %q", s)
+	}
+	var d time.Duration
+	for i, u := range prometheusUnits {
+		n, _ := strconv.Atoi(m[i+1])
+		d += time.Duration(n) * u.duration
+	}
+	return d, nil
+}
+
+func formatPrometheusDuration(d time.Duration) (string, error) {
+	if d%time.Millisecond != 0 {
+		return "", fmt.Errorf("Prometheus durations must be whole milliseconds: %s", d)
+	}
+	return formatUnits(d, prometheusUnits, "", "0s"), nil
+}
+
+// parseSystemdTimespan parses s like `1h 30min` or `90`. Numbers without unit
+// are seconds, a month is 30.44 days and a year is 365.25 days.
+func parseSystemdTimespan(s string) (time.Duration, error) {
+	rest := strings.TrimSpace(s)
+	if rest == "" {
+		return 0, fmt.Errorf("invalid systemd timespan %q", s)
+	}
+	var d time.Duration
+	for rest != "" {
+		m := systemdTimespanToken.FindStringSubmatch(rest)
+		if m == nil {
+			return 0, fmt.Errorf("invalid systemd timespan %q", s)
+		}
+		unit, ok := systemdUnits[m[2]]
+		if !ok {
+			return 0, fmt.Errorf("unknown unit %q of systemd timespan %q", m[2], s)
+		}
+		n, _ := strconv.ParseFloat(m[1], 64)
+		d += time.Duration(math.Round(n * float64(unit)))
+		rest = strings.TrimSpace(rest[len(m[0]):])
+	}
+	return d, nil
+}
+
+func formatSystemdTimespan(d time.Duration) (string, error) {
+	if d%time.Microsecond != 0 {
+		return "", fmt.Errorf("systemd timespans must be whole microseconds: %s", d)
+	}
+	return formatUnits(d, systemdFormatUnits, " ", "0"), nil
+}
+
+// parseSecondsDuration parses s as an integer number of seconds.
+func parseSecondsDuration(s string) (time.Duration, error) {
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number of seconds %q", s)
+	}
+	return time.Duration(n) * time.Second, nil
+}
+
+func formatSecondsDuration(d time.Duration) (string, error) {
+	if d%time.Second != 0 {
+		return "", fmt.Errorf("durations must be whole seconds: %s", d)
+	}
+	return strconv.FormatInt(int64(d/time.Second), 10), nil
+}
+
+// parseRateDuration parses s like `rate(90 minutes)` or `90 minutes`.
+func parseRateDuration(s string) (time.Duration, error) {
+	body := s
+	if m := cronWrapper.FindStringSubmatch(s); m != nil && m[1] == "rate" {
+		body = m[2]
+	}
+	m := rateBody.FindStringSubmatch(body)
+	if m == nil {
+		return 0, fmt.Errorf("invalid rate expression %q", s)
+	}
+	value, err := strconv.Atoi(m[1])
+	if err != nil || value < 1 {
+		return 0, fmt.Errorf("rate value must be a positive integer: %q", s)
+	}
+	unit := strings.TrimSuffix(m[2], "s")
+	if (value == 1) != (unit == m[2]) {
+		return 0, fmt.Errorf("rate unit must be singular for 1 and plural otherwise: %q", s)
+	}
+	for _, u := range rateUnits {
+		if u.name == unit {
+			return time.Duration(value) * u.duration, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown rate unit %q", m[2])
+}
+
+// formatRateDuration formats d in the largest rate unit dividing it.
+func formatRateDuration(d time.Duration) (string, error) {
+	for _, u := range rateUnits {
+		if d > 0 && d%u.duration == 0 {
+			n := d / u.duration
+			if n == 1 {
+				return fmt.Sprintf("rate(1 %s)", u.name), nil
+			}
+			return fmt.Sprintf("rate(%d %ss)", n, u.name), nil
+		}
+	}
+	return "", fmt.Errorf("rate expressions must be positive whole minutes: %s", d)
+}
+
+type durationParse struct{}
+
+// Definition implements function.Function.
+func (d *durationParse) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a duration string into seconds",
+		Description: fmt.Sprintf("Parse a duration string in the style and return the number of seconds. Styles are `%s`. `kubernetes` is the same as `go`, `iso8601` does not accept years and months, `cloudwatch` is an integer number of seconds, and `aws_rate` accepts `rate(90 minutes)` as well as `90 minutes`.", strings.Join(durationStyleNames(), "`, `")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "value",
+				Description:    "Input duration string. like `1h30m`, `PT1H30M`, `1h 30min`, `rate(90 minutes)`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "style",
+				Description:    "Style of the duration string",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (d *durationParse) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_parse"
+}
+
+// Run implements function.Function.
+func (d *durationParse) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, styleName string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value, &styleName))
+	if resp.Error != nil {
+		return
+	}
+
+	style, err := loadDurationStyle(styleName)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	duration, err := style.parse(value)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, duration.Seconds()))
+}
+
+var _ function.Function = (*durationParse)(nil)
+
+func NewDurationParseFunction() function.Function {
+	return &durationParse{}
+}
+
+type durationFormat struct{}
+
+// Definition implements function.Function.
+func (d *durationFormat) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Format seconds as a duration string",
+		Description: fmt.Sprintf("Format the number of seconds as a duration string in the style. Styles are `%s`. Only `go` and `kubernetes` accept negative seconds. `iso8601` uses hours, minutes and seconds, `systemd` uses weeks to microseconds, and `aws_rate` uses the largest of days, hours and minutes dividing seconds.", strings.Join(durationStyleNames(), "`, `")),
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:           "seconds",
+				Description:    "Number of seconds",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "style",
+				Description:    "Style of the duration string",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (d *durationFormat) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_format"
+}
+
+// Run implements function.Function.
+func (d *durationFormat) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seconds float64
+	var styleName string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &seconds, &styleName))
+	if resp.Error != nil {
+		return
+	}
+
+	style, err := loadDurationStyle(styleName)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	if math.Abs(seconds) > math.MaxInt64/float64(time.Second) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("seconds out of range: %v", seconds)))
+		return
+	}
+	if seconds < 0 && !style.signed {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%s durations must not be negative: %v", style.description, seconds)))
+		return
+	}
+	output, err := style.format(time.Duration(math.Round(seconds * float64(time.Second))))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*durationFormat)(nil)
+
+func NewDurationFormatFunction() function.Function {
+	return &durationFormat{}
+}
@@ -0,0 +1,128 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDurationParseFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "go" {
+					value = provider::timeconv::duration_parse("1h30m", "go")
+				}
+				output "iso8601" {
+					value = provider::timeconv::duration_parse("P1DT0.5S", "iso8601")
+				}
+				output "prometheus" {
+					value = provider::timeconv::duration_parse("1w", "prometheus")
+				}
+				output "systemd" {
+					value = provider::timeconv::duration_parse("1h 30min", "systemd")
+				}
+				output "cloudwatch" {
+					value = provider::timeconv::duration_parse("5400", "cloudwatch")
+				}
+				output "aws_rate" {
+					value = provider::timeconv::duration_parse("rate(90 minutes)", "aws_rate")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("go", knownvalue.Int64Exact(5400)),
+					statecheck.ExpectKnownOutputValue("iso8601", knownvalue.Float64Exact(86400.5)),
+					statecheck.ExpectKnownOutputValue("prometheus", knownvalue.Int64Exact(604800)),
+					statecheck.ExpectKnownOutputValue("systemd", knownvalue.Int64Exact(5400)),
+					statecheck.ExpectKnownOutputValue("cloudwatch", knownvalue.Int64Exact(5400)),
+					statecheck.ExpectKnownOutputValue("aws_rate", knownvalue.Int64Exact(5400)),
+				},
+			},
+			{
+				Config: `output "calendar_months" {
+					value = provider::timeconv::duration_parse("P1M", "iso8601")
+				}`,
+				ExpectError: regexp.MustCompile(`years\s+and\s+months\s+have\s+no\s+fixed\s+length`),
+			},
+			{
+				Config: `output "unknown_style" {
+					value = provider::timeconv::duration_parse("1h", "cron")
+				}`,
+				ExpectError: regexp.MustCompile(`unknown\s+duration\s+style`),
+			},
+		},
+	})
+}
+
+func TestDurationFormatFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "go" {
+					value = provider::timeconv::duration_format(5400, "go")
+				}
+				output "iso8601" {
+					value = provider::timeconv::duration_format(5400, "iso8601")
+				}
+				output "prometheus" {
+					value = provider::timeconv::duration_format(5400, "prometheus")
+				}
+				output "systemd" {
+					value = provider::timeconv::duration_format(5400, "systemd")
+				}
+				output "cloudwatch" {
+					value = provider::timeconv::duration_format(5400, "cloudwatch")
+				}
+				output "aws_rate" {
+					value = provider::timeconv::duration_format(5400, "aws_rate")
+				}
+				output "aws_rate_singular" {
+					value = provider::timeconv::duration_format(86400, "aws_rate")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("go", knownvalue.StringExact("1h30m0s")),
+					statecheck.ExpectKnownOutputValue("iso8601", knownvalue.StringExact("PT1H30M")),
+					statecheck.ExpectKnownOutputValue("prometheus", knownvalue.StringExact("1h30m")),
+					statecheck.ExpectKnownOutputValue("systemd", knownvalue.StringExact("1h 30min")),
+					statecheck.ExpectKnownOutputValue("cloudwatch", knownvalue.StringExact("5400")),
+					statecheck.ExpectKnownOutputValue("aws_rate", knownvalue.StringExact("rate(90 minutes)")),
+					statecheck.ExpectKnownOutputValue("aws_rate_singular", knownvalue.StringExact("rate(1 day)")),
+				},
+			},
+			{
+				Config: `output "negative" {
+					value = provider::timeconv::duration_format(-60, "prometheus")
+				}`,
+				ExpectError: regexp.MustCompile(`must\s+not\s+be\s+negative`),
+			},
+			{
+				Config: `output "not_minutes" {
+					value = provider::timeconv::duration_format(90, "aws_rate")
+				}`,
+				ExpectError: regexp.MustCompile(`positive\s+whole\s+minutes`),
+			},
+		},
+	})
+}
@@ -5,6 +5,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -16,7 +18,7 @@ type format struct{}
 func (f *format) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Format a time string",
-		Description: "Format a time string using the specified format(golang time package style).",
+		Description: fmt.Sprintf("Format a time string using the specified format(golang time package style). If locale is given, month and weekday names(`January`, `Jan`, `Monday`, `Mon`), AM/PM markers(`PM`, `pm`) and the era(`AD`) are in the language of the locale. For `ja`, `平成18`, `平成` and `H18` are the Japanese era with the year(`元` for the first year), the era and the abbreviation with the year. Supported languages are `%s`.", strings.Join(timeLocaleNames(), "`, `")),
 
 		Parameters: []function.Parameter{
 			function.StringParameter{
@@ -31,6 +33,10 @@ func (f *format) Definition(_ context.Context, _ function.DefinitionRequest, res
 				AllowNullValue: false,
 			},
 		},
+		VariadicParameter: function.StringParameter{
+			Name:        "locale",
+			Description: "Optional BCP 47 locale of names. like `ja-JP`, `de-DE`, `fr-FR`",
+		},
 		Return: function.StringReturn{},
 	}
 }
@@ -44,11 +50,31 @@ func (f *format) Metadata(_ context.Context, _ function.MetadataRequest, resp *f
 func (f *format) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input timetypes.RFC3339
 	var outputFormat string
+	var locale []string
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &outputFormat))
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &outputFormat, &locale))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(locale) > 1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, "at most one locale can be given"))
+		return
+	}
 
 	t, _ := input.ValueRFC3339Time()
 	output := t.Format(outputFormat)
+	if len(locale) == 1 {
+		l, err := loadTimeLocale(locale[0])
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+			return
+		}
+		if output, err = formatInLocale(t, outputFormat, l); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+			return
+		}
+	}
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
 }
 
@@ -30,10 +30,22 @@ func TestFormatFonction(t *testing.T) {
 				output "formatted_time_with_ANSIC" {
 					value = provider::timeconv::format("2024-08-07T01:23:45Z", "Mon Jan _2 15:04:05 2006")
 				}
+				output "formatted_time_in_japanese" {
+					value = provider::timeconv::format("2024-08-07T13:23:45+09:00", "2006年1月2日(Mon) PM3時04分", "ja-JP")
+				}
+				output "formatted_time_in_japanese_era" {
+					value = provider::timeconv::format("2019-05-01T09:00:00+09:00", "平成18年1月2日 H18.01.02", "ja")
+				}
+				output "formatted_time_in_french" {
+					value = provider::timeconv::format("2024-08-07T01:23:45Z", "Monday 2 January 2006 AD", "fr-FR")
+				}
 				`,
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("formatted_time", knownvalue.StringExact("2024-08-31 01:23:45")),
 					statecheck.ExpectKnownOutputValue("formatted_time_with_ANSIC", knownvalue.StringExact("Wed Aug  7 01:23:45 2024")),
+					statecheck.ExpectKnownOutputValue("formatted_time_in_japanese", knownvalue.StringExact("2024年8月7日(水) 午後1時23分")),
+					statecheck.ExpectKnownOutputValue("formatted_time_in_japanese_era", knownvalue.StringExact("令和元年5月1日 R1.05.01")),
+					statecheck.ExpectKnownOutputValue("formatted_time_in_french", knownvalue.StringExact("mercredi 7 août 2024 ap. J.-C.")),
 				},
 			},
 			{
@@ -42,6 +54,12 @@ func TestFormatFonction(t *testing.T) {
 				}`,
 				ExpectError: regexp.MustCompile(`Invalid function argument`),
 			},
+			{
+				Config: `output "unsupported_locale" {
+					value = provider::timeconv::format("2024-08-31T00:00:00Z", "January", "xx-XX")
+				}`,
+				ExpectError: regexp.MustCompile(`unsupported\s+locale`),
+			},
 		},
 	})
 }
@@ -0,0 +1,254 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// humanizeLocale is the wording of humanized strings in a language.
+type humanizeLocale struct {
+	// singular and plural are the names of humanizeUnits.
+	singular, plural []string
+	// sep joins the units.
+	sep string
+	// future and past are the formats of relative times.
+	future, past string
+	now          string
+}
+
+var (
+	// humanizeUnits are the units of humanized strings. A year is 365 days and
+	// a month is 30 days.
+	humanizeUnits   = []time.Duration{365 * dayDuration, 30 * dayDuration, dayDuration, time.Hour, time.Minute, time.Second}
+	humanizeLocales = map[string]humanizeLocale{
+		"en": {
+			singular: []string{"%d year", "%d month", "%d day", "%d hour", "%d minute", "%d second"},
+			plural:   []string{"%d years", "%d months", "%d days", "%d hours", "%d minutes", "%d seconds"},
+			sep:      " ",
+			future:   "in %s",
+			past:     "%s ago",
+			now:      "now",
+		},
+		"ja": {
+			singular: []string{"%d年", "%dか月", "%d日", "%d時間", "%d分", "%d秒"},
+			plural:   []string{"%d年", "%dか月", "%d日", "%d時間", "%d分", "%d秒"},
+			sep:      "",
+			future:   "%s後",
+			past:     "%s前",
+			now:      "今",
+		},
+	}
+)
+
+// localeLanguage returns the lowercased language subtag of the BCP 47 tag.
+// like `ja` for `ja-JP`.
+func localeLanguage(tag string) string {
+	lang, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	return strings.ToLower(lang)
+}
+
+// humanizeOptions are the options of humanize functions.
+type humanizeOptions struct {
+	locale humanizeLocale
+	units  int
+}
+
+// parseHumanizeOptions parses options with units defaulting to units.
+func parseHumanizeOptions(options map[string]string, units int) (humanizeOptions, error) {
+	opts := humanizeOptions{locale: humanizeLocales["en"], units: units}
+	for key, value := range options {
+		switch key {
+		case "locale":
+			locale, ok := humanizeLocales[localeLanguage(value)]
+			if !ok {
+				names := make([]string, 0, len(humanizeLocales))
+				for name := range humanizeLocales {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				return opts, fmt.Errorf("unsupported locale %q, must be one of `%s`", value, strings.Join(names, "`, `"))
+			}
+			opts.locale = locale
+		case "units":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return opts, fmt.Errorf("units must be a positive integer: %q", value)
+			}
+			opts.units = n
+		default:
+			return opts, fmt.Errorf("unknown option %q, must be `locale` or `units`", key)
+		}
+	}
+	return opts, nil
+}
+
+// durationWords returns d in at most opts.units of the largest units. The
+// rest is truncated, and the result is empty if d is shorter than a second.
+func durationWords(d time.Duration, opts humanizeOptions) string {
+	parts := []string{}
+	for i, unit := range humanizeUnits {
+		if len(parts) == opts.units {
+			break
+		}
+		n := d / unit
+		d -= n * unit
+		if n == 0 {
+			// Skipped units still count once the largest unit is found.
+			if len(parts) > 0 {
+				opts.units--
+			}
+			continue
+		}
+		format := opts.locale.plural[i]
+		if n == 1 {
+			format = opts.locale.singular[i]
+		}
+		parts = append(parts, fmt.Sprintf(format, n))
+	}
+	return strings.Join(parts, opts.locale.sep)
+}
+
+type humanizeDuration struct{}
+
+// Definition implements function.Function.
+func (h *humanizeDuration) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Humanize a duration in seconds",
+		Description: "Return the number of seconds in words like `2 days 3 hours` or `2日3時間`. A year is 365 days and a month is 30 days, and the units smaller than the requested ones are truncated. Options are `locale`(`en` or `ja`, default `en`) and `units`(the number of units from the largest, default 2).",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:           "seconds",
+				Description:    "Number of seconds",
+				AllowNullValue: false,
+			},
+			function.MapParameter{
+				Name:           "options",
+				Description:    "Options like `{ locale = \"ja\", units = 1 }`",
+				ElementType:    types.StringType,
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (h *humanizeDuration) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "humanize_duration"
+}
+
+// Run implements function.Function.
+func (h *humanizeDuration) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seconds float64
+	var options map[string]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &seconds, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := parseHumanizeOptions(options, 2)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	if seconds < 0 || seconds > math.MaxInt64/float64(time.Second) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("seconds out of range: %v", seconds)))
+		return
+	}
+	output := durationWords(time.Duration(seconds*float64(time.Second)), opts)
+	if output == "" {
+		output = fmt.Sprintf(opts.locale.plural[len(humanizeUnits)-1], 0)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*humanizeDuration)(nil)
+
+func NewHumanizeDurationFunction() function.Function {
+	return &humanizeDuration{}
+}
+
+type humanizeRelative struct{}
+
+// Definition implements function.Function.
+func (h *humanizeRelative) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Humanize a time relative to the reference",
+		Description: "Return the time relative to the reference in words like `in 3 days`, `5 minutes ago`, `3日後` or `5分前`. Options are the same as `humanize_duration` except that `units` defaults to 1.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in RFC3339 format",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "reference",
+				Description:    "Reference time string in RFC3339 format which input is relative to, usually `plantimestamp()`",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+			function.MapParameter{
+				Name:           "options",
+				Description:    "Options like `{ locale = \"ja\", units = 2 }`",
+				ElementType:    types.StringType,
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (h *humanizeRelative) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "humanize_relative"
+}
+
+// Run implements function.Function.
+func (h *humanizeRelative) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, reference timetypes.RFC3339
+	var options map[string]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &reference, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := parseHumanizeOptions(options, 1)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+	t, _ := input.ValueRFC3339Time()
+	ref, _ := reference.ValueRFC3339Time()
+
+	d := t.Sub(ref)
+	format := opts.locale.future
+	if d < 0 {
+		d, format = -d, opts.locale.past
+	}
+	output := opts.locale.now
+	if words := durationWords(d, opts); words != "" {
+		output = fmt.Sprintf(format, words)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*humanizeRelative)(nil)
+
+func NewHumanizeRelativeFunction() function.Function {
+	return &humanizeRelative{}
+}
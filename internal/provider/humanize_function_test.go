@@ -0,0 +1,112 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestHumanizeDurationFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "default" {
+					value = provider::timeconv::humanize_duration(183659, null)
+				}
+				output "skipped_unit" {
+					value = provider::timeconv::humanize_duration(86700, null)
+				}
+				output "zero" {
+					value = provider::timeconv::humanize_duration(0, null)
+				}
+				output "japanese" {
+					value = provider::timeconv::humanize_duration(183659, { locale = "ja-JP", units = 3 })
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("default", knownvalue.StringExact("2 days 3 hours")),
+					statecheck.ExpectKnownOutputValue("skipped_unit", knownvalue.StringExact("1 day")),
+					statecheck.ExpectKnownOutputValue("zero", knownvalue.StringExact("0 seconds")),
+					statecheck.ExpectKnownOutputValue("japanese", knownvalue.StringExact("2日3時間")),
+				},
+			},
+			{
+				Config: `output "unsupported_locale" {
+					value = provider::timeconv::humanize_duration(60, { locale = "fr" })
+				}`,
+				ExpectError: regexp.MustCompile(`unsupported\s+locale`),
+			},
+			{
+				Config: `output "negative" {
+					value = provider::timeconv::humanize_duration(-60, null)
+				}`,
+				ExpectError: regexp.MustCompile(`seconds\s+out\s+of\s+range`),
+			},
+		},
+	})
+}
+
+func TestHumanizeRelativeFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "future" {
+					value = provider::timeconv::humanize_relative("2026-01-04T00:00:00Z", "2026-01-01T00:00:00Z", null)
+				}
+				output "past" {
+					value = provider::timeconv::humanize_relative("2026-01-01T00:00:00Z", "2026-01-01T00:05:30Z", null)
+				}
+				output "japanese_future" {
+					value = provider::timeconv::humanize_relative("2026-01-04T09:00:00+09:00", "2026-01-01T00:00:00Z", { locale = "ja" })
+				}
+				output "japanese_past" {
+					value = provider::timeconv::humanize_relative("2026-01-01T00:00:00Z", "2026-01-01T00:05:30Z", { locale = "ja", units = 2 })
+				}
+				output "now" {
+					value = provider::timeconv::humanize_relative("2026-01-01T00:00:00Z", "2026-01-01T00:00:00Z", null)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("future", knownvalue.StringExact("in 3 days")),
+					statecheck.ExpectKnownOutputValue("past", knownvalue.StringExact("5 minutes ago")),
+					statecheck.ExpectKnownOutputValue("japanese_future", knownvalue.StringExact("3日後")),
+					statecheck.ExpectKnownOutputValue("japanese_past", knownvalue.StringExact("5分30秒前")),
+					statecheck.ExpectKnownOutputValue("now", knownvalue.StringExact("now")),
+				},
+			},
+			{
+				Config: `output "unknown_option" {
+					value = provider::timeconv::humanize_relative("2026-01-01T00:00:00Z", "2026-01-01T00:00:00Z", { precision = 2 })
+				}`,
+				ExpectError: regexp.MustCompile(`unknown\s+option`),
+			},
+			{
+				Config: `output "null_reference" {
+					value = provider::timeconv::humanize_relative("2026-01-01T00:00:00Z", null, null)
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid\s+function\s+argument`),
+			},
+		},
+	})
+}
@@ -0,0 +1,436 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	intervalParamAttrTypes = map[string]attr.Type{
+		"start": timetypes.RFC3339Type{},
+		"end":   timetypes.RFC3339Type{},
+	}
+	intervalAttrTypes = map[string]attr.Type{
+		"start": types.StringType,
+		"end":   types.StringType,
+	}
+)
+
+// intervalModel is an interval argument.
+type intervalModel struct {
+	Start timetypes.RFC3339 `tfsdk:"start"`
+	End   timetypes.RFC3339 `tfsdk:"end"`
+}
+
+// interval is a half-open interval [start, end). The input strings are kept
+// to return the endpoints with their UTC offsets.
+type interval struct {
+	start, end       time.Time
+	startStr, endStr string
+}
+
+func (m intervalModel) interval() (interval, error) {
+	if m.Start.IsNull() || m.End.IsNull() {
+		return interval{}, fmt.Errorf("start and end must not be null")
+	}
+	start, _ := m.Start.ValueRFC3339Time()
+	end, _ := m.End.ValueRFC3339Time()
+	if end.Before(start) {
+		return interval{}, fmt.Errorf("end must not be before start: %s - %s", m.Start.ValueString(), m.End.ValueString())
+	}
+	return interval{start: start, end: end, startStr: m.Start.ValueString(), endStr: m.End.ValueString()}, nil
+}
+
+// empty reports whether i contains no instant, that is start == end.
+func (i interval) empty() bool {
+	return !i.start.Before(i.end)
+}
+
+// overlaps reports whether i and o share any instant, so empty intervals
+// overlap nothing.
+func (i interval) overlaps(o interval) bool {
+	return !i.empty() && !o.empty() && i.start.Before(o.end) && o.start.Before(i.end)
+}
+
+func (i interval) contains(o interval) bool {
+	return !o.start.Before(i.start) && !o.end.After(i.end)
+}
+
+func (i interval) value() attr.Value {
+	v, _ := types.ObjectValue(intervalAttrTypes, map[string]attr.Value{
+		"start": types.StringValue(i.startStr),
+		"end":   types.StringValue(i.endStr),
+	})
+	return v
+}
+
+// intervalArguments converts the interval arguments from position pos.
+func intervalArguments(pos int, models ...intervalModel) ([]interval, *function.FuncError) {
+	intervals := make([]interval, 0, len(models))
+	for _, m := range models {
+		i, err := m.interval()
+		if err != nil {
+			return nil, function.NewArgumentFuncError(int64(pos), err.Error())
+		}
+		intervals = append(intervals, i)
+	}
+	return intervals, nil
+}
+
+// unionIntervals returns the sorted intervals merging the overlapping or
+// adjacent ones. Empty intervals cover nothing, so they are dropped.
+func unionIntervals(intervals []interval) []interval {
+	sorted := []interval{}
+	for _, i := range intervals {
+		if !i.empty() {
+			sorted = append(sorted, i)
+		}
+	}
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return sorted[a].start.Before(sorted[b].start)
+	})
+	merged := []interval{}
+	for _, i := range sorted {
+		if n := len(merged); n > 0 && !i.start.After(merged[n-1].end) {
+			if i.end.After(merged[n-1].end) {
+				merged[n-1].end, merged[n-1].endStr = i.end, i.endStr
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+func intervalListValue(ctx context.Context, intervals []interval) (types.List, *function.FuncError) {
+	values := make([]attr.Value, 0, len(intervals))
+	for _, i := range intervals {
+		values = append(values, i.value())
+	}
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: intervalAttrTypes}, values)
+	return list, function.FuncErrorFromDiags(ctx, diags)
+}
+
+func intervalParameter(name, description string) function.Parameter {
+	return function.ObjectParameter{
+		Name:           name,
+		Description:    description + ". Object with `start` and `end` in RFC3339 format. end is exclusive.",
+		AttributeTypes: intervalParamAttrTypes,
+		AllowNullValue: false,
+	}
+}
+
+func intervalListParameter(name, description string) function.Parameter {
+	return function.ListParameter{
+		Name:           name,
+		Description:    description + ". List of objects with `start` and `end` in RFC3339 format. end is exclusive.",
+		ElementType:    types.ObjectType{AttrTypes: intervalParamAttrTypes},
+		AllowNullValue: false,
+	}
+}
+
+type intervalOverlaps struct{}
+
+// Definition implements function.Function.
+func (f *intervalOverlaps) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether two intervals overlap",
+		Description: "Return true if intervals a and b share any instant. Adjacent intervals do not overlap since end is exclusive, and empty intervals(start == end) overlap nothing.",
+
+		Parameters: []function.Parameter{
+			intervalParameter("a", "Interval"),
+			intervalParameter("b", "Interval"),
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (f *intervalOverlaps) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_overlaps"
+}
+
+// Run implements function.Function.
+func (f *intervalOverlaps) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b intervalModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+	i, err := intervalArguments(0, a)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+	o, err := intervalArguments(1, b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, i[0].overlaps(o[0])))
+}
+
+var _ function.Function = (*intervalOverlaps)(nil)
+
+func NewIntervalOverlapsFunction() function.Function {
+	return &intervalOverlaps{}
+}
+
+type intervalIntersect struct{}
+
+// Definition implements function.Function.
+func (f *intervalIntersect) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return intersection of two intervals",
+		Description: "Return the interval shared by intervals a and b, or null if they do not overlap.",
+
+		Parameters: []function.Parameter{
+			intervalParameter("a", "Interval"),
+			intervalParameter("b", "Interval"),
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: intervalAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (f *intervalIntersect) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_intersect"
+}
+
+// Run implements function.Function.
+func (f *intervalIntersect) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b intervalModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+	i, err := intervalArguments(0, a)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+	o, err := intervalArguments(1, b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+
+	if !i[0].overlaps(o[0]) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.ObjectNull(intervalAttrTypes)))
+		return
+	}
+	output := i[0]
+	if o[0].start.After(output.start) {
+		output.start, output.startStr = o[0].start, o[0].startStr
+	}
+	if o[0].end.Before(output.end) {
+		output.end, output.endStr = o[0].end, o[0].endStr
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output.value()))
+}
+
+var _ function.Function = (*intervalIntersect)(nil)
+
+func NewIntervalIntersectFunction() function.Function {
+	return &intervalIntersect{}
+}
+
+type intervalUnion struct{}
+
+// Definition implements function.Function.
+func (f *intervalUnion) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Merge intervals",
+		Description: "Return the list of intervals sorted by start, merging overlapping or adjacent intervals and dropping empty intervals(start == end).",
+
+		Parameters: []function.Parameter{
+			intervalListParameter("intervals", "Intervals"),
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: intervalAttrTypes},
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (f *intervalUnion) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_union"
+}
+
+// Run implements function.Function.
+func (f *intervalUnion) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var models []intervalModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &models))
+	if resp.Error != nil {
+		return
+	}
+	intervals, err := intervalArguments(0, models...)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+
+	output, err := intervalListValue(ctx, unionIntervals(intervals))
+	resp.Error = function.ConcatFuncErrors(resp.Error, err)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*intervalUnion)(nil)
+
+func NewIntervalUnionFunction() function.Function {
+	return &intervalUnion{}
+}
+
+type intervalContains struct{}
+
+// Definition implements function.Function.
+func (f *intervalContains) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether interval contains another",
+		Description: "Return true if interval a contains the whole of interval b.",
+
+		Parameters: []function.Parameter{
+			intervalParameter("a", "Containing interval"),
+			intervalParameter("b", "Contained interval"),
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (f *intervalContains) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_contains"
+}
+
+// Run implements function.Function.
+func (f *intervalContains) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b intervalModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+	i, err := intervalArguments(0, a)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+	o, err := intervalArguments(1, b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, i[0].contains(o[0])))
+}
+
+var _ function.Function = (*intervalContains)(nil)
+
+func NewIntervalContainsFunction() function.Function {
+	return &intervalContains{}
+}
+
+type intervalGaps struct{}
+
+// Definition implements function.Function.
+func (f *intervalGaps) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return gaps between intervals",
+		Description: "Return the list of intervals not covered by intervals, sorted by start. If within is not null, the gaps are limited to within and include the ones at its start and end. Otherwise only the gaps between intervals are returned. Empty intervals(start == end) cover nothing.",
+
+		Parameters: []function.Parameter{
+			intervalListParameter("intervals", "Intervals"),
+			function.ObjectParameter{
+				Name:           "within",
+				Description:    "Interval to find the gaps in. Object with `start` and `end` in RFC3339 format. end is exclusive.",
+				AttributeTypes: intervalParamAttrTypes,
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: intervalAttrTypes},
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (f *intervalGaps) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_gaps"
+}
+
+// Run implements function.Function.
+func (f *intervalGaps) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var models []intervalModel
+	var within *intervalModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &models, &within))
+	if resp.Error != nil {
+		return
+	}
+	intervals, err := intervalArguments(0, models...)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+
+	merged := unionIntervals(intervals)
+	gaps := []interval{}
+	for n := 1; n < len(merged); n++ {
+		gaps = append(gaps, interval{
+			start: merged[n-1].end, startStr: merged[n-1].endStr,
+			end: merged[n].start, endStr: merged[n].startStr,
+		})
+	}
+	if within != nil {
+		bounds, err := intervalArguments(1, *within)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, err)
+			return
+		}
+		b := bounds[0]
+		if len(merged) == 0 {
+			gaps = append(gaps, b)
+		} else {
+			gaps = append([]interval{{start: b.start, startStr: b.startStr, end: merged[0].start, endStr: merged[0].startStr}}, gaps...)
+			gaps = append(gaps, interval{start: merged[len(merged)-1].end, startStr: merged[len(merged)-1].endStr, end: b.end, endStr: b.endStr})
+		}
+		clipped := []interval{}
+		for _, g := range gaps {
+			if !g.overlaps(b) {
+				continue
+			}
+			if b.start.After(g.start) {
+				g.start, g.startStr = b.start, b.startStr
+			}
+			if b.end.Before(g.end) {
+				g.end, g.endStr = b.end, b.endStr
+			}
+			clipped = append(clipped, g)
+		}
+		gaps = clipped
+	}
+
+	output, err := intervalListValue(ctx, gaps)
+	resp.Error = function.ConcatFuncErrors(resp.Error, err)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*intervalGaps)(nil)
+
+func NewIntervalGapsFunction() function.Function {
+	return &intervalGaps{}
+}
@@ -0,0 +1,203 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const testIntervalLocals = `
+locals {
+	a      = { start = "2024-01-01T00:00:00Z", end = "2024-01-10T00:00:00Z" }
+	b      = { start = "2024-01-05T09:00:00+09:00", end = "2024-01-20T00:00:00Z" }
+	c      = { start = "2024-01-10T00:00:00Z", end = "2024-01-12T00:00:00Z" }
+	d      = { start = "2024-02-01T00:00:00Z", end = "2024-02-02T00:00:00Z" }
+	within = { start = "2023-12-01T00:00:00Z", end = "2024-03-01T00:00:00Z" }
+}
+`
+
+func expectInterval(start, end string) knownvalue.Check {
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		"start": knownvalue.StringExact(start),
+		"end":   knownvalue.StringExact(end),
+	})
+}
+
+func TestIntervalOverlapsFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIntervalLocals + `
+				output "overlapping" {
+					value = provider::timeconv::interval_overlaps(local.a, local.b)
+				}
+				output "adjacent" {
+					value = provider::timeconv::interval_overlaps(local.a, local.c)
+				}
+				output "empty" {
+					value = provider::timeconv::interval_overlaps(local.a, { start = "2024-01-05T00:00:00Z", end = "2024-01-05T00:00:00Z" })
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("overlapping", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("adjacent", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("empty", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: `
+				output "invalid" {
+					value = provider::timeconv::interval_overlaps(
+						{ start = "2024-02-03T00:00:00Z", end = "2024-02-02T00:00:00Z" },
+						{ start = "2024-02-01T00:00:00Z", end = "2024-02-02T00:00:00Z" },
+					)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`end\s+must\s+not\s+be\s+before\s+start`),
+			},
+			{
+				Config: `
+				output "null_start" {
+					value = provider::timeconv::interval_overlaps(
+						{ start = null, end = "2024-02-02T00:00:00Z" },
+						{ start = "2024-02-01T00:00:00Z", end = "2024-02-02T00:00:00Z" },
+					)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`start\s+and\s+end\s+must\s+not\s+be\s+null`),
+			},
+		},
+	})
+}
+
+func TestIntervalIntersectFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIntervalLocals + `
+				output "overlapping" {
+					value = provider::timeconv::interval_intersect(local.a, local.b)
+				}
+				output "adjacent" {
+					value = provider::timeconv::interval_intersect(local.a, local.c)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("overlapping", expectInterval("2024-01-05T09:00:00+09:00", "2024-01-10T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("adjacent", knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestIntervalUnionFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIntervalLocals + `
+				output "union" {
+					value = provider::timeconv::interval_union([local.d, local.c, local.a])
+				}
+				output "empty" {
+					value = provider::timeconv::interval_union([local.d, { start = "2024-01-20T00:00:00Z", end = "2024-01-20T00:00:00Z" }])
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("union", knownvalue.ListExact([]knownvalue.Check{
+						expectInterval("2024-01-01T00:00:00Z", "2024-01-12T00:00:00Z"),
+						expectInterval("2024-02-01T00:00:00Z", "2024-02-02T00:00:00Z"),
+					})),
+					statecheck.ExpectKnownOutputValue("empty", knownvalue.ListExact([]knownvalue.Check{
+						expectInterval("2024-02-01T00:00:00Z", "2024-02-02T00:00:00Z"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestIntervalContainsFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIntervalLocals + `
+				output "contained" {
+					value = provider::timeconv::interval_contains(local.within, local.a)
+				}
+				output "not_contained" {
+					value = provider::timeconv::interval_contains(local.a, local.b)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("contained", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("not_contained", knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func TestIntervalGapsFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIntervalLocals + `
+				output "between" {
+					value = provider::timeconv::interval_gaps([local.d, local.c, local.a], null)
+				}
+				output "within" {
+					value = provider::timeconv::interval_gaps([local.d, local.c, local.a], local.within)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("between", knownvalue.ListExact([]knownvalue.Check{
+						expectInterval("2024-01-12T00:00:00Z", "2024-02-01T00:00:00Z"),
+					})),
+					statecheck.ExpectKnownOutputValue("within", knownvalue.ListExact([]knownvalue.Check{
+						expectInterval("2023-12-01T00:00:00Z", "2024-01-01T00:00:00Z"),
+						expectInterval("2024-01-12T00:00:00Z", "2024-02-01T00:00:00Z"),
+						expectInterval("2024-02-02T00:00:00Z", "2024-03-01T00:00:00Z"),
+					})),
+				},
+			},
+		},
+	})
+}
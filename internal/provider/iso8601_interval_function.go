@@ -0,0 +1,362 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxRepetitions is the maximum number of instances of a repeating interval.
+const maxRepetitions = 1000
+
+var (
+	iso8601DurationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+	iso8601TimeLayouts     = []string{
+		time.RFC3339Nano,
+		"2006-01-02T15:04Z07:00",
+		"20060102T150405Z0700",
+		"20060102T1504Z0700",
+	}
+	iso8601LocalTimeLayouts = []string{
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02T15:04",
+		"2006-01-02",
+		"20060102T150405",
+		"20060102",
+	}
+	isoIntervalAttrTypes = map[string]attr.Type{
+		"start":    types.StringType,
+		"end":      types.StringType,
+		"duration": types.StringType,
+	}
+)
+
+// iso8601Duration is an ISO 8601 duration. The date part is calendar based,
+// and the time part is elapsed time.
+type iso8601Duration struct {
+	years, months, days int
+	elapsed             time.Duration
+	input               string
+}
+
+// parseISO8601Duration parses s in `PnYnMnWnDTnHnMnS` format.
+func parseISO8601Duration(s string) (iso8601Duration, error) {
+	m := iso8601DurationPattern.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return iso8601Duration{}, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+	n := func(i int) int {
+		v, _ := strconv.Atoi(m[i])
+		return v
+	}
+	seconds, _ := strconv.ParseFloat(strings.Replace(m[7], ",", ".", 1), 64)
+	return iso8601Duration{
+		years:   n(1),
+		months:  n(2),
+		days:    n(3)*7 + n(4),
+		elapsed: time.Duration(n(5))*time.Hour + time.Duration(n(6))*time.Minute + time.Duration(seconds*float64(time.Second)),
+		input:   s,
+	}, nil
+}
+
+// addTo returns t plus the duration multiplied by n, which can be negative.
+// Adding months clamps the day to the end of the month, so 2024-01-31 plus
+// P1M is 2024-02-29.
+func (d iso8601Duration) addTo(t time.Time, n int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month, 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()).AddDate(d.years*n, d.months*n, 0)
+	last := first.AddDate(0, 1, -1).Day()
+	t = first.AddDate(0, 0, min(day, last)-1)
+	return t.AddDate(0, 0, d.days*n).Add(d.elapsed * time.Duration(n))
+}
+
+// formatISO8601Duration formats d in `PTnHnMnS` format.
+func formatISO8601Duration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	s := sign + "PT"
+	if h := d / time.Hour; h > 0 {
+		s += fmt.Sprintf("%dH", h)
+	}
+	if m := d % time.Hour / time.Minute; m > 0 {
+		s += fmt.Sprintf("%dM", m)
+	}
+	if sec := d % time.Minute; sec > 0 {
+		s += strconv.FormatFloat(sec.Seconds(), 'f', -1, 64) + "S"
+	}
+	return s
+}
+
+// parseISO8601Time parses s in ISO 8601 extended or basic format. Times
+// without UTC offset are in loc.
+func parseISO8601Time(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range iso8601TimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	for _, layout := range iso8601LocalTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid ISO 8601 time %q", s)
+}
+
+// isoInterval is a parsed ISO 8601 interval. If backward is true, the
+// interval is given as duration and end, and repeats backward.
+type isoInterval struct {
+	start, end time.Time
+	duration   iso8601Duration
+	backward   bool
+}
+
+// parseISO8601Interval parses s in `start/end`, `start/duration` or
+// `duration/end` format.
+func parseISO8601Interval(s string, loc *time.Location) (isoInterval, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return isoInterval{}, fmt.Errorf("invalid ISO 8601 interval %q", s)
+	}
+	switch {
+	case strings.HasPrefix(parts[0], "P") && strings.HasPrefix(parts[1], "P"):
+		return isoInterval{}, fmt.Errorf("invalid ISO 8601 interval %q: both are durations", s)
+	case strings.HasPrefix(parts[0], "P"):
+		d, err := parseISO8601Duration(parts[0])
+		if err != nil {
+			return isoInterval{}, err
+		}
+		end, err := parseISO8601Time(parts[1], loc)
+		if err != nil {
+			return isoInterval{}, err
+		}
+		return isoInterval{start: d.addTo(end, -1), end: end, duration: d, backward: true}, nil
+	case strings.HasPrefix(parts[1], "P"):
+		start, err := parseISO8601Time(parts[0], loc)
+		if err != nil {
+			return isoInterval{}, err
+		}
+		d, err := parseISO8601Duration(parts[1])
+		if err != nil {
+			return isoInterval{}, err
+		}
+		return isoInterval{start: start, end: d.addTo(start, 1), duration: d}, nil
+	}
+	start, err := parseISO8601Time(parts[0], loc)
+	if err != nil {
+		return isoInterval{}, err
+	}
+	end, err := parseISO8601Time(parts[1], loc)
+	if err != nil {
+		return isoInterval{}, err
+	}
+	if end.Before(start) {
+		return isoInterval{}, fmt.Errorf("end must not be before start: %q", s)
+	}
+	elapsed := end.Sub(start)
+	return isoInterval{start: start, end: end, duration: iso8601Duration{elapsed: elapsed, input: formatISO8601Duration(elapsed)}}, nil
+}
+
+// instance returns the nth repetition of i. Both ends are computed from the
+// given time, so instances are contiguous even across short months.
+func (i isoInterval) instance(n int) (time.Time, time.Time) {
+	if i.backward {
+		return i.duration.addTo(i.end, -n-1), i.duration.addTo(i.end, -n)
+	}
+	return i.duration.addTo(i.start, n), i.duration.addTo(i.start, n+1)
+}
+
+func isoIntervalValue(start, end time.Time, duration string) attr.Value {
+	v, _ := types.ObjectValue(isoIntervalAttrTypes, map[string]attr.Value{
+		"start":    types.StringValue(start.Format(time.RFC3339Nano)),
+		"end":      types.StringValue(end.Format(time.RFC3339Nano)),
+		"duration": types.StringValue(duration),
+	})
+	return v
+}
+
+type parseInterval struct{}
+
+// Definition implements function.Function.
+func (p *parseInterval) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an ISO 8601 interval",
+		Description: "Parse an ISO 8601 interval in `start/end`, `start/duration` or `duration/end` format, and return `start` and `end` in RFC3339 and `duration` in ISO 8601 format. Years, months, weeks and days of durations are calendar based. If location is null, times without UTC offset are in UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input ISO 8601 interval string. like `2026-01-01T00:00Z/P1D`, `P1W/2026-02-01T00:00Z`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location of times without UTC offset. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: isoIntervalAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (p *parseInterval) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_interval"
+}
+
+// Run implements function.Function.
+func (p *parseInterval) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var location types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &location))
+	if resp.Error != nil {
+		return
+	}
+
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	i, err := parseISO8601Interval(input, loc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, isoIntervalValue(i.start, i.end, i.duration.input)))
+}
+
+var _ function.Function = (*parseInterval)(nil)
+
+func NewParseIntervalFunction() function.Function {
+	return &parseInterval{}
+}
+
+type expandRepeatingInterval struct{}
+
+// Definition implements function.Function.
+func (e *expandRepeatingInterval) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Expand an ISO 8601 repeating interval",
+		Description: fmt.Sprintf("Expand an ISO 8601 repeating interval(`Rn/interval`) to the list of instances with `start`, `end` and `duration` sorted by start. `R/interval` repeats unboundedly, so limit is required. `Rn/duration/end` repeats backward from end. At most %d instances can be expanded, and a larger count or limit is an error. If location is null, times without UTC offset are in UTC.", maxRepetitions),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input ISO 8601 repeating interval string. like `R5/2026-01-01T00:00Z/PT6H`",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location of times without UTC offset. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+			function.Int64Parameter{
+				Name:           "limit",
+				Description:    "Maximum number of instances",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: isoIntervalAttrTypes},
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (e *expandRepeatingInterval) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "expand_repeating_interval"
+}
+
+// Run implements function.Function.
+func (e *expandRepeatingInterval) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var location types.String
+	var limit types.Int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &location, &limit))
+	if resp.Error != nil {
+		return
+	}
+
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	repeat, interval, ok := strings.Cut(input, "/")
+	if !ok || !strings.HasPrefix(repeat, "R") {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("invalid ISO 8601 repeating interval %q", input)))
+		return
+	}
+	count := -1
+	if repeat != "R" {
+		n, err := strconv.Atoi(repeat[1:])
+		if err != nil || n < 0 {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("invalid number of repetitions %q", repeat)))
+			return
+		}
+		count = n
+	}
+	if !limit.IsNull() {
+		if limit.ValueInt64() < 0 {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, "limit must not be negative"))
+			return
+		}
+		if limit.ValueInt64() > maxRepetitions {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("limit %d is too large, must be at most %d", limit.ValueInt64(), maxRepetitions)))
+			return
+		}
+		if count < 0 || limit.ValueInt64() < int64(count) {
+			count = int(limit.ValueInt64())
+		}
+	}
+	if count < 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, "limit is required for unbounded repeating interval"))
+		return
+	}
+	if count > maxRepetitions {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("too many instances %d, must be at most %d", count, maxRepetitions)))
+		return
+	}
+
+	i, err := parseISO8601Interval(interval, loc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	values := make([]attr.Value, count)
+	for n := range count {
+		start, end := i.instance(n)
+		idx := n
+		if i.backward {
+			idx = count - 1 - n
+		}
+		values[idx] = isoIntervalValue(start, end, i.duration.input)
+	}
+	output, diags := types.ListValue(types.ObjectType{AttrTypes: isoIntervalAttrTypes}, values)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*expandRepeatingInterval)(nil)
+
+func NewExpandRepeatingIntervalFunction() function.Function {
+	return &expandRepeatingInterval{}
+}
@@ -0,0 +1,164 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseIntervalFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "start_duration" {
+					value = provider::timeconv::parse_interval("2026-01-01T00:00Z/P1D", null)
+				}
+				output "start_end" {
+					value = provider::timeconv::parse_interval("2026-01-01T00:00:00Z/2026-01-02T12:30:00Z", null)
+				}
+				output "duration_end" {
+					value = provider::timeconv::parse_interval("P1W/2026-02-01T00:00Z", null)
+				}
+				output "end_of_month" {
+					value = provider::timeconv::parse_interval("2026-01-31/P1M", "Asia/Tokyo")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("start_duration", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"start":    knownvalue.StringExact("2026-01-01T00:00:00Z"),
+						"end":      knownvalue.StringExact("2026-01-02T00:00:00Z"),
+						"duration": knownvalue.StringExact("P1D"),
+					})),
+					statecheck.ExpectKnownOutputValue("start_end", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"start":    knownvalue.StringExact("2026-01-01T00:00:00Z"),
+						"end":      knownvalue.StringExact("2026-01-02T12:30:00Z"),
+						"duration": knownvalue.StringExact("PT36H30M"),
+					})),
+					statecheck.ExpectKnownOutputValue("duration_end", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"start":    knownvalue.StringExact("2026-01-25T00:00:00Z"),
+						"end":      knownvalue.StringExact("2026-02-01T00:00:00Z"),
+						"duration": knownvalue.StringExact("P1W"),
+					})),
+					statecheck.ExpectKnownOutputValue("end_of_month", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"start":    knownvalue.StringExact("2026-01-31T00:00:00+09:00"),
+						"end":      knownvalue.StringExact("2026-02-28T00:00:00+09:00"),
+						"duration": knownvalue.StringExact("P1M"),
+					})),
+				},
+			},
+			{
+				Config: `output "both_durations" {
+					value = provider::timeconv::parse_interval("P1D/P1D", null)
+				}`,
+				ExpectError: regexp.MustCompile(`both\s+are\s+durations`),
+			},
+			{
+				Config: `output "reversed" {
+					value = provider::timeconv::parse_interval("2026-01-02T00:00Z/2026-01-01T00:00Z", null)
+				}`,
+				ExpectError: regexp.MustCompile(`end\s+must\s+not\s+be\s+before\s+start`),
+			},
+		},
+	})
+}
+
+func TestExpandRepeatingIntervalFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "forward" {
+					value = provider::timeconv::expand_repeating_interval("R3/2026-01-01T00:00Z/PT6H", null, null)
+				}
+				output "backward" {
+					value = provider::timeconv::expand_repeating_interval("R2/P1D/2026-01-10T00:00Z", null, null)
+				}
+				output "unbounded" {
+					value = provider::timeconv::expand_repeating_interval("R/2026-01-31T00:00Z/P1M", null, 2)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("forward", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"start":    knownvalue.StringExact("2026-01-01T00:00:00Z"),
+							"end":      knownvalue.StringExact("2026-01-01T06:00:00Z"),
+							"duration": knownvalue.StringExact("PT6H"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"start":    knownvalue.StringExact("2026-01-01T06:00:00Z"),
+							"end":      knownvalue.StringExact("2026-01-01T12:00:00Z"),
+							"duration": knownvalue.StringExact("PT6H"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"start":    knownvalue.StringExact("2026-01-01T12:00:00Z"),
+							"end":      knownvalue.StringExact("2026-01-01T18:00:00Z"),
+							"duration": knownvalue.StringExact("PT6H"),
+						}),
+					})),
+					statecheck.ExpectKnownOutputValue("backward", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"start":    knownvalue.StringExact("2026-01-08T00:00:00Z"),
+							"end":      knownvalue.StringExact("2026-01-09T00:00:00Z"),
+							"duration": knownvalue.StringExact("P1D"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"start":    knownvalue.StringExact("2026-01-09T00:00:00Z"),
+							"end":      knownvalue.StringExact("2026-01-10T00:00:00Z"),
+							"duration": knownvalue.StringExact("P1D"),
+						}),
+					})),
+					statecheck.ExpectKnownOutputValue("unbounded", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"start":    knownvalue.StringExact("2026-01-31T00:00:00Z"),
+							"end":      knownvalue.StringExact("2026-02-28T00:00:00Z"),
+							"duration": knownvalue.StringExact("P1M"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"start":    knownvalue.StringExact("2026-02-28T00:00:00Z"),
+							"end":      knownvalue.StringExact("2026-03-31T00:00:00Z"),
+							"duration": knownvalue.StringExact("P1M"),
+						}),
+					})),
+				},
+			},
+			{
+				Config: `output "unbounded_without_limit" {
+					value = provider::timeconv::expand_repeating_interval("R/2026-01-01T00:00Z/P1D", null, null)
+				}`,
+				ExpectError: regexp.MustCompile(`limit\s+is\s+required`),
+			},
+			{
+				Config: `output "too_many" {
+					value = provider::timeconv::expand_repeating_interval("R5000/2026-01-01T00:00Z/P1D", null, null)
+				}`,
+				ExpectError: regexp.MustCompile(`too\s+many\s+instances`),
+			},
+			{
+				Config: `output "limit_too_large" {
+					value = provider::timeconv::expand_repeating_interval("R/2026-01-01T00:00Z/P1D", null, 5000)
+				}`,
+				ExpectError: regexp.MustCompile(`limit\s+5000\s+is\s+too\s+large,\s+must\s+be\s+at\s+most\s+1000`),
+			},
+		},
+	})
+}
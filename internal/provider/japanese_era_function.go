@@ -0,0 +1,248 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// japaneseEra is an era of the Japanese calendar since the Meiji era.
+type japaneseEra struct {
+	name, abbreviation string
+	// year, month and day are the first date of the era in the Gregorian
+	// calendar.
+	year  int
+	month time.Month
+	day   int
+}
+
+var (
+	// japaneseEras are sorted by the first date. Dates in the Meiji era before
+	// 1873 are in the Gregorian calendar, not in the lunisolar calendar used
+	// at that time.
+	japaneseEras = []japaneseEra{
+		{name: "明治", abbreviation: "M", year: 1868, month: time.October, day: 23},
+		{name: "大正", abbreviation: "T", year: 1912, month: time.July, day: 30},
+		{name: "昭和", abbreviation: "S", year: 1926, month: time.December, day: 25},
+		{name: "平成", abbreviation: "H", year: 1989, month: time.January, day: 8},
+		{name: "令和", abbreviation: "R", year: 2019, month: time.May, day: 1},
+	}
+	japaneseEraDate      = regexp.MustCompile(`^(明治|大正|昭和|平成|令和)\s*(元|\d+)\s*年\s*(\d+)\s*月\s*(\d+)\s*日$`)
+	japaneseEraShortDate = regexp.MustCompile(`^([MTSHRmtshr])\.?(\d+)[./-](\d+)[./-](\d+)$`)
+	// japaneseEraYearPrefix and japaneseEraShortYearPrefix match the era with
+	// the year at the head of an input for the layout tokens.
+	japaneseEraYearPrefix      = regexp.MustCompile(`^(明治|大正|昭和|平成|令和)(元|\d+)`)
+	japaneseEraShortYearPrefix = regexp.MustCompile(`^([MTSHRmtshr])(\d+)`)
+	japaneseEraAttrTypes       = map[string]attr.Type{
+		"era":              types.StringType,
+		"era_abbreviation": types.StringType,
+		"era_year":         types.Int64Type,
+		"month":            types.Int64Type,
+		"day":              types.Int64Type,
+		"date":             types.StringType,
+		"short_date":       types.StringType,
+	}
+	fullWidthChars = strings.NewReplacer(
+		"０", "0", "１", "1", "２", "2", "３", "3", "４", "4", "５", "5", "６", "6", "７", "7", "８", "8", "９", "9",
+		"Ｍ", "M", "Ｔ", "T", "Ｓ", "S", "Ｈ", "H", "Ｒ", "R", "．", ".", "／", "/", "－", "-",
+	)
+)
+
+// contains reports whether the date is on or after the first date of e.
+func (e *japaneseEra) contains(year int, month time.Month, day int) bool {
+	return year > e.year || year == e.year && (month > e.month || month == e.month && day >= e.day)
+}
+
+// findJapaneseEra returns the era of the date and the year in the era.
+func findJapaneseEra(year int, month time.Month, day int) (*japaneseEra, int, error) {
+	for i := len(japaneseEras) - 1; i >= 0; i-- {
+		if e := &japaneseEras[i]; e.contains(year, month, day) {
+			return e, year - e.year + 1, nil
+		}
+	}
+	e := japaneseEras[0]
+	return nil, 0, fmt.Errorf("dates before %s(%04d-%02d-%02d) are not supported: %04d-%02d-%02d", e.name, e.year, e.month, e.day, year, month, day)
+}
+
+// japaneseEraYear returns the year in the era in kanji notation, which is
+// `元` for the first year.
+func japaneseEraYear(year int) string {
+	if year == 1 {
+		return "元"
+	}
+	return strconv.Itoa(year)
+}
+
+// parseJapaneseEraDate parses s like `令和8年10月18日`, `令和元年5月1日` or
+// `R8.10.18` and returns the date in the Gregorian calendar.
+func parseJapaneseEraDate(s string) (int, time.Month, int, error) {
+	s = fullWidthChars.Replace(strings.TrimSpace(s))
+	var era *japaneseEra
+	var fields []string
+	if m := japaneseEraDate.FindStringSubmatch(s); m != nil {
+		for i := range japaneseEras {
+			if japaneseEras[i].name == m[1] {
+				era = &japaneseEras[i]
+			}
+		}
+		fields = m[2:]
+		if fields[0] == "元" {
+			fields[0] = "1"
+		}
+	} else if m := japaneseEraShortDate.FindStringSubmatch(s); m != nil {
+		for i := range japaneseEras {
+			if japaneseEras[i].abbreviation == strings.ToUpper(m[1]) {
+				era = &japaneseEras[i]
+			}
+		}
+		fields = m[2:]
+	} else {
+		return 0, 0, 0, fmt.Errorf("invalid Japanese era date %q", s)
+	}
+
+	eraYear, _ := strconv.Atoi(fields[0])
+	month, _ := strconv.Atoi(fields[1])
+	day, _ := strconv.Atoi(fields[2])
+	year := era.year + eraYear - 1
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if eraYear < 1 || t.Year() != year || t.Month() != time.Month(month) || t.Day() != day {
+		return 0, 0, 0, fmt.Errorf("invalid date %q", s)
+	}
+	found, _, err := findJapaneseEra(year, time.Month(month), day)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if found != era {
+		return 0, 0, 0, fmt.Errorf("%q is not in %s, but in %s", s, era.name, found.name)
+	}
+	return year, time.Month(month), day, nil
+}
+
+type toJapaneseEra struct{}
+
+// Definition implements function.Function.
+func (j *toJapaneseEra) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a time to a Japanese era date",
+		Description: "Convert the date of a time string in its UTC offset to the Japanese era(和暦) from Meiji through Reiwa. It returns an object with `era`(like `令和`), `era_abbreviation`(like `R`), `era_year`, `month`, `day`, `date`(like `令和8年10月18日`, the first year is `元年`) and `short_date`(like `R8.10.18`). Dates before Meiji(1868-10-23) are not supported.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in RFC3339 format",
+				CustomType:     timetypes.RFC3339Type{},
+				AllowNullValue: false,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: japaneseEraAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (j *toJapaneseEra) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_japanese_era"
+}
+
+// Run implements function.Function.
+func (j *toJapaneseEra) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input timetypes.RFC3339
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	t, _ := input.ValueRFC3339Time()
+	era, year, err := findJapaneseEra(t.Date())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	output, diags := types.ObjectValue(japaneseEraAttrTypes, map[string]attr.Value{
+		"era":              types.StringValue(era.name),
+		"era_abbreviation": types.StringValue(era.abbreviation),
+		"era_year":         types.Int64Value(int64(year)),
+		"month":            types.Int64Value(int64(t.Month())),
+		"day":              types.Int64Value(int64(t.Day())),
+		"date":             types.StringValue(fmt.Sprintf("%s%s年%d月%d日", era.name, japaneseEraYear(year), t.Month(), t.Day())),
+		"short_date":       types.StringValue(fmt.Sprintf("%s%d.%d.%d", era.abbreviation, year, t.Month(), t.Day())),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*toJapaneseEra)(nil)
+
+func NewToJapaneseEraFunction() function.Function {
+	return &toJapaneseEra{}
+}
+
+type fromJapaneseEra struct{}
+
+// Definition implements function.Function.
+func (j *fromJapaneseEra) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a Japanese era date to a time",
+		Description: "Convert a Japanese era(和暦) date like `令和8年10月18日`, `令和元年5月1日` or `R8.10.18` to the time string at the start of the day in location in RFC3339. Full-width digits and letters are accepted. The date must be within the era. If location is null, UTC is used.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input Japanese era date string",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (j *fromJapaneseEra) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "from_japanese_era"
+}
+
+// Run implements function.Function.
+func (j *fromJapaneseEra) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var location types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &location))
+	if resp.Error != nil {
+		return
+	}
+
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	year, month, day, err := parseJapaneseEraDate(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, timetypes.NewRFC3339TimeValue(t)))
+}
+
+var _ function.Function = (*fromJapaneseEra)(nil)
+
+func NewFromJapaneseEraFunction() function.Function {
+	return &fromJapaneseEra{}
+}
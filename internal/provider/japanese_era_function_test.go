@@ -0,0 +1,112 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestToJapaneseEraFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "reiwa" {
+					value = provider::timeconv::to_japanese_era("2026-10-18T09:00:00+09:00")
+				}
+				output "first_year" {
+					value = provider::timeconv::to_japanese_era("2019-05-01T00:00:00+09:00")
+				}
+				output "last_day_of_heisei" {
+					value = provider::timeconv::to_japanese_era("2019-04-30T23:59:59+09:00")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("reiwa", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"era":              knownvalue.StringExact("令和"),
+						"era_abbreviation": knownvalue.StringExact("R"),
+						"era_year":         knownvalue.Int64Exact(8),
+						"month":            knownvalue.Int64Exact(10),
+						"day":              knownvalue.Int64Exact(18),
+						"date":             knownvalue.StringExact("令和8年10月18日"),
+						"short_date":       knownvalue.StringExact("R8.10.18"),
+					})),
+					statecheck.ExpectKnownOutputValue("first_year", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"date":       knownvalue.StringExact("令和元年5月1日"),
+						"short_date": knownvalue.StringExact("R1.5.1"),
+					})),
+					statecheck.ExpectKnownOutputValue("last_day_of_heisei", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"date":       knownvalue.StringExact("平成31年4月30日"),
+						"short_date": knownvalue.StringExact("H31.4.30"),
+					})),
+				},
+			},
+			{
+				Config: `output "before_meiji" {
+					value = provider::timeconv::to_japanese_era("1868-10-22T00:00:00Z")
+				}`,
+				ExpectError: regexp.MustCompile(`are\s+not\s+supported`),
+			},
+		},
+	})
+}
+
+func TestFromJapaneseEraFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "kanji" {
+					value = provider::timeconv::from_japanese_era("令和8年10月18日", "Asia/Tokyo")
+				}
+				output "first_year" {
+					value = provider::timeconv::from_japanese_era("令和元年5月1日", null)
+				}
+				output "short" {
+					value = provider::timeconv::from_japanese_era("R8.10.18", null)
+				}
+				output "full_width" {
+					value = provider::timeconv::from_japanese_era("Ｈ３１．４．３０", null)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("kanji", knownvalue.StringExact("2026-10-18T00:00:00+09:00")),
+					statecheck.ExpectKnownOutputValue("first_year", knownvalue.StringExact("2019-05-01T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("short", knownvalue.StringExact("2026-10-18T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("full_width", knownvalue.StringExact("2019-04-30T00:00:00Z")),
+				},
+			},
+			{
+				Config: `output "after_the_era" {
+					value = provider::timeconv::from_japanese_era("平成31年5月1日", null)
+				}`,
+				ExpectError: regexp.MustCompile(`is\s+not\s+in\s+平成`),
+			},
+			{
+				Config: `output "invalid_date" {
+					value = provider::timeconv::from_japanese_era("令和8年2月30日", null)
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+date`),
+			},
+		},
+	})
+}
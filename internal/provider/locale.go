@@ -0,0 +1,445 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeLocale is the names used in formatting times in a locale, taken from
+// the Gregorian calendar of CLDR.
+type timeLocale struct {
+	months, shortMonths     [12]string
+	weekdays, shortWeekdays [7]string
+	// dayPeriods are AM and PM.
+	dayPeriods [2]string
+	// eras are AD and BC.
+	eras [2]string
+	// japaneseEra enables the tokens of the Japanese era.
+	japaneseEra bool
+}
+
+// Layout tokens replaced by the names of timeLocale. `AD` is not a token of
+// the time package, and is the era of the reference time.
+const (
+	layoutLongMonth   = "January"
+	layoutMonth       = "Jan"
+	layoutLongWeekday = "Monday"
+	layoutWeekday     = "Mon"
+	layoutPM          = "PM"
+	layoutLowerPM     = "pm"
+	layoutEra         = "AD"
+)
+
+// Layout tokens of the Japanese era, which are the era and the year in the era
+// of the reference time. `平成18` is the era with the year(`元` for the first
+// year), `平成` is the era only, and `H18` is the abbreviation with the year.
+const (
+	layoutJapaneseEraYear      = "平成18"
+	layoutJapaneseEra          = "平成"
+	layoutJapaneseEraShortYear = "H18"
+)
+
+var timeLocales = map[string]*timeLocale{
+	"en": {
+		months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		shortMonths:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		shortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		dayPeriods:    [2]string{"AM", "PM"},
+		eras:          [2]string{"AD", "BC"},
+	},
+	"ja": {
+		months:        [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		shortMonths:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:      [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		shortWeekdays: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		dayPeriods:    [2]string{"午前", "午後"},
+		eras:          [2]string{"西暦", "紀元前"},
+		japaneseEra:   true,
+	},
+	"de": {
+		months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		shortWeekdays: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		dayPeriods:    [2]string{"AM", "PM"},
+		eras:          [2]string{"n. Chr.", "v. Chr."},
+	},
+	"fr": {
+		months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		dayPeriods:    [2]string{"AM", "PM"},
+		eras:          [2]string{"ap. J.-C.", "av. J.-C."},
+	},
+	"es": {
+		months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortMonths:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		shortWeekdays: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		dayPeriods:    [2]string{"a. m.", "p. m."},
+		eras:          [2]string{"d. C.", "a. C."},
+	},
+	"it": {
+		months:        [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		shortMonths:   [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		weekdays:      [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		shortWeekdays: [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		dayPeriods:    [2]string{"AM", "PM"},
+		eras:          [2]string{"d.C.", "a.C."},
+	},
+	"pt": {
+		months:        [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		shortMonths:   [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+		weekdays:      [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		shortWeekdays: [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+		dayPeriods:    [2]string{"AM", "PM"},
+		eras:          [2]string{"d.C.", "a.C."},
+	},
+	"nl": {
+		months:        [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		shortMonths:   [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		weekdays:      [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		shortWeekdays: [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		dayPeriods:    [2]string{"a.m.", "p.m."},
+		eras:          [2]string{"n.Chr.", "v.Chr."},
+	},
+	"zh": {
+		months:        [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+		shortMonths:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:      [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		shortWeekdays: [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		dayPeriods:    [2]string{"上午", "下午"},
+		eras:          [2]string{"公元", "公元前"},
+	},
+	"ko": {
+		months:        [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		shortMonths:   [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		weekdays:      [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
+		shortWeekdays: [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		dayPeriods:    [2]string{"오전", "오후"},
+		eras:          [2]string{"AD", "BC"},
+	},
+}
+
+// timeLocaleNames returns the sorted names of timeLocales.
+func timeLocaleNames() []string {
+	names := make([]string, 0, len(timeLocales))
+	for name := range timeLocales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadTimeLocale returns the timeLocale of the language of the BCP 47 tag.
+func loadTimeLocale(tag string) (*timeLocale, error) {
+	l, ok := timeLocales[localeLanguage(tag)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported locale %q, must be one of `%s`", tag, strings.Join(timeLocaleNames(), "`, `"))
+	}
+	return l, nil
+}
+
+// startsWithLowerCase reports whether s starts with a lower case letter, in
+// which case the time package does not take `Jan` or `Mon` as a token.
+func startsWithLowerCase(s string) bool {
+	return s != "" && 'a' <= s[0] && s[0] <= 'z'
+}
+
+// nameToken returns the name token at the head of layout in the same manner
+// as the time package, or empty if none.
+func nameToken(layout string, prev byte) string {
+	switch {
+	case strings.HasPrefix(layout, layoutLongMonth):
+		return layoutLongMonth
+	case strings.HasPrefix(layout, layoutMonth) && !startsWithLowerCase(layout[3:]):
+		return layoutMonth
+	case strings.HasPrefix(layout, layoutLongWeekday):
+		return layoutLongWeekday
+	case strings.HasPrefix(layout, layoutWeekday) && !startsWithLowerCase(layout[3:]):
+		return layoutWeekday
+	case strings.HasPrefix(layout, layoutPM):
+		return layoutPM
+	case strings.HasPrefix(layout, layoutLowerPM):
+		return layoutLowerPM
+	case strings.HasPrefix(layout, layoutEra) && !isLetter(prev) && (len(layout) == 2 || !isLetter(layout[2])):
+		return layoutEra
+	}
+	return ""
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// name returns the name of t for token.
+func (l *timeLocale) name(t time.Time, token string) string {
+	switch token {
+	case layoutLongMonth:
+		return l.months[t.Month()-1]
+	case layoutMonth:
+		return l.shortMonths[t.Month()-1]
+	case layoutLongWeekday:
+		return l.weekdays[t.Weekday()]
+	case layoutWeekday:
+		return l.shortWeekdays[t.Weekday()]
+	case layoutPM, layoutLowerPM:
+		period := l.dayPeriods[t.Hour()/12]
+		if token == layoutLowerPM {
+			period = strings.ToLower(period)
+		}
+		return period
+	case layoutEra:
+		if t.Year() <= 0 {
+			return l.eras[1]
+		}
+		return l.eras[0]
+	}
+	return ""
+}
+
+// names returns the names of l for token.
+func (l *timeLocale) names(token string) []string {
+	switch token {
+	case layoutLongMonth:
+		return l.months[:]
+	case layoutMonth:
+		return l.shortMonths[:]
+	case layoutLongWeekday:
+		return l.weekdays[:]
+	case layoutWeekday:
+		return l.shortWeekdays[:]
+	case layoutPM:
+		return l.dayPeriods[:]
+	case layoutLowerPM:
+		return []string{strings.ToLower(l.dayPeriods[0]), strings.ToLower(l.dayPeriods[1])}
+	case layoutEra:
+		return l.eras[:]
+	}
+	return nil
+}
+
+// matchName returns the index of the longest name of names at the head of s
+// ignoring case, or -1 if none.
+func matchName(s string, names []string) int {
+	match := -1
+	for i, name := range names {
+		if len(name) <= len(s) && strings.EqualFold(s[:len(name)], name) && (match < 0 || len(name) > len(names[match])) {
+			match = i
+		}
+	}
+	return match
+}
+
+// toEnglish replaces the names of l in input with English ones along layout,
+// so that the time package can parse it. Japanese era tokens with the year are
+// replaced with the Gregorian year in both layout and input, and the eras are
+// returned to be checked against the parsed time. Each name is searched from
+// the head of the rest of input where the preceding part of layout can be
+// parsed.
+func (l *timeLocale) toEnglish(layout, input string) (string, string, []*japaneseEra, error) {
+	en := timeLocales["en"]
+	var lb, b strings.Builder
+	eras := []*japaneseEra{}
+	start, rest := 0, input
+	for i := 0; i < len(layout); i++ {
+		var prev byte
+		if i > 0 {
+			prev = layout[i-1]
+		}
+		token, era := nameToken(layout[i:], prev), false
+		if token == "" && l.japaneseEra {
+			token, era = japaneseEraToken(layout[i:]), true
+		}
+		if token == "" {
+			continue
+		}
+		if token == layoutJapaneseEra {
+			return "", "", nil, fmt.Errorf("%q of the layout has no year in the era and cannot be parsed, use %q or %q, or from_japanese_era", token, layoutJapaneseEraYear, layoutJapaneseEraShortYear)
+		}
+		chunk := layout[start:i]
+		found := false
+		for pos := 0; pos <= len(rest) && !found; pos++ {
+			var name, replacement string
+			var e *japaneseEra
+			if era {
+				var year, n int
+				if e, year, n = matchJapaneseEraYear(rest[pos:], token); e == nil {
+					continue
+				}
+				name, replacement = rest[pos:pos+n], strconv.Itoa(year)
+			} else {
+				names := l.names(token)
+				match := matchName(rest[pos:], names)
+				if match < 0 {
+					continue
+				}
+				if token == layoutEra && match == 1 {
+					return "", "", nil, fmt.Errorf("dates before the common era are not supported: %q", input)
+				}
+				name, replacement = names[match], en.names(token)[match]
+			}
+			if _, err := time.Parse(chunk, rest[:pos]); err != nil {
+				continue
+			}
+			if e != nil {
+				eras = append(eras, e)
+			}
+			b.WriteString(rest[:pos])
+			b.WriteString(replacement)
+			rest = rest[pos+len(name):]
+			found = true
+		}
+		if !found {
+			return "", "", nil, fmt.Errorf("cannot find the name for %q of the layout in %q", token, input)
+		}
+		lb.WriteString(chunk)
+		if era {
+			lb.WriteString("2006")
+		} else {
+			lb.WriteString(token)
+		}
+		i += len(token) - 1
+		start = i + 1
+	}
+	lb.WriteString(layout[start:])
+	b.WriteString(rest)
+	return lb.String(), b.String(), eras, nil
+}
+
+// localizeInput returns layout and input with the names in the locale replaced
+// with English ones, and the Japanese eras to be checked by checkJapaneseEras.
+// If no locale is given, layout and input are returned as is.
+func localizeInput(layout, input string, locale []string) (string, string, []*japaneseEra, error) {
+	if len(locale) == 0 {
+		return layout, input, nil, nil
+	}
+	if len(locale) > 1 {
+		return "", "", nil, fmt.Errorf("at most one locale can be given")
+	}
+	l, err := loadTimeLocale(locale[0])
+	if err != nil {
+		return "", "", nil, err
+	}
+	return l.toEnglish(layout, input)
+}
+
+// matchJapaneseEraYear returns the era, the Gregorian year and the length of
+// the era with the year at the head of s for token, or nil if none.
+func matchJapaneseEraYear(s, token string) (*japaneseEra, int, int) {
+	pattern := japaneseEraYearPrefix
+	if token == layoutJapaneseEraShortYear {
+		pattern = japaneseEraShortYearPrefix
+	}
+	m := pattern.FindStringSubmatch(s)
+	if m == nil {
+		return nil, 0, 0
+	}
+	year := 1
+	if m[2] != "元" {
+		year, _ = strconv.Atoi(m[2])
+	}
+	if year < 1 {
+		return nil, 0, 0
+	}
+	for i := range japaneseEras {
+		if e := &japaneseEras[i]; e.name == m[1] || e.abbreviation == strings.ToUpper(m[1]) {
+			return e, e.year + year - 1, len(m[0])
+		}
+	}
+	return nil, 0, 0
+}
+
+// checkJapaneseEras returns an error if t is not in all of eras.
+func checkJapaneseEras(t time.Time, eras []*japaneseEra) error {
+	for _, era := range eras {
+		found, _, err := findJapaneseEra(t.Date())
+		if err != nil {
+			return err
+		}
+		if found != era {
+			return fmt.Errorf("%s is not in %s, but in %s", t.Format(time.DateOnly), era.name, found.name)
+		}
+	}
+	return nil
+}
+
+// japaneseEraToken returns the Japanese era token at the head of layout, or
+// empty if none.
+func japaneseEraToken(layout string) string {
+	for _, token := range []string{layoutJapaneseEraYear, layoutJapaneseEra, layoutJapaneseEraShortYear} {
+		if strings.HasPrefix(layout, token) {
+			return token
+		}
+	}
+	return ""
+}
+
+// japaneseEraName returns the Japanese era of t for token.
+func japaneseEraName(t time.Time, token string) (string, error) {
+	era, year, err := findJapaneseEra(t.Date())
+	if err != nil {
+		return "", err
+	}
+	switch token {
+	case layoutJapaneseEraYear:
+		return era.name + japaneseEraYear(year), nil
+	case layoutJapaneseEra:
+		return era.name, nil
+	}
+	return era.abbreviation + strconv.Itoa(year), nil
+}
+
+// formatInLocale formats t by layout with the names in l. Name tokens of the
+// layout are replaced, and the rest is formatted by the time package.
+func formatInLocale(t time.Time, layout string, l *timeLocale) (string, error) {
+	var b strings.Builder
+	start := 0
+	for i := 0; i < len(layout); i++ {
+		var prev byte
+		if i > 0 {
+			prev = layout[i-1]
+		}
+		var name string
+		token := nameToken(layout[i:], prev)
+		if token != "" {
+			name = l.name(t, token)
+		} else if token = japaneseEraToken(layout[i:]); token != "" && l.japaneseEra {
+			var err error
+			if name, err = japaneseEraName(t, token); err != nil {
+				return "", err
+			}
+		} else {
+			continue
+		}
+		b.WriteString(t.Format(layout[start:i]))
+		b.WriteString(name)
+		i += len(token) - 1
+		start = i + 1
+	}
+	b.WriteString(t.Format(layout[start:]))
+	return b.String(), nil
+}
+
+// formatWithLocale formats t by layout with the names in the locale, or in
+// English if locale is null or empty.
+func formatWithLocale(t time.Time, layout string, locale types.String) (string, error) {
+	if locale.ValueString() == "" {
+		return t.Format(layout), nil
+	}
+	l, err := loadTimeLocale(locale.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return formatInLocale(t, layout, l)
+}
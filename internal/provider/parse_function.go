@@ -5,6 +5,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -18,7 +20,7 @@ type parse struct{}
 func (p *parse) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Parse a time string",
-		Description: "Parse a time string using the specified format(golang time package style). If layout is null, RFC3339 format is used. See: https://pkg.go.dev/time#Parse",
+		Description: fmt.Sprintf("Parse a time string using the specified format(golang time package style). If layout is null, RFC3339 format is used. If locale is given, month and weekday names(`January`, `Jan`, `Monday`, `Mon`), AM/PM markers(`PM`, `pm`) and the era(`AD`) are read in the language of the locale ignoring case. For `ja`, `平成18` and `H18` are read as the Japanese era with the year. Supported languages are `%s`. See: https://pkg.go.dev/time#Parse", strings.Join(timeLocaleNames(), "`, `")),
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:           "layout",
@@ -31,6 +33,10 @@ func (p *parse) Definition(_ context.Context, _ function.DefinitionRequest, resp
 				AllowNullValue: false,
 			},
 		},
+		VariadicParameter: function.StringParameter{
+			Name:        "locale",
+			Description: "Optional BCP 47 locale of names. like `ja-JP`, `de-DE`, `fr-FR`",
+		},
 		Return: function.StringReturn{},
 	}
 }
@@ -44,17 +50,30 @@ func (p *parse) Metadata(_ context.Context, _ function.MetadataRequest, resp *fu
 func (p *parse) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var layout types.String
 	var input string
+	var locale []string
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &layout, &input))
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &layout, &input, &locale))
+	if resp.Error != nil {
+		return
+	}
 	layoutString := time.RFC3339
 	if !layout.IsNull() {
 		layoutString = layout.ValueString()
 	}
+	layoutString, input, eras, err := localizeInput(layoutString, input, locale)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
 	t, err := time.Parse(layoutString, input)
 	if err != nil {
 		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
 		return
 	}
+	if err := checkJapaneseEras(t, eras); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, timetypes.NewRFC3339TimeValue(t)))
 }
 
@@ -70,7 +89,7 @@ type parseInLocation struct{}
 func (p *parseInLocation) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Parse a time string",
-		Description: "Parse a time string using the specified format(golang time package style). If layout is null, RFC3339 format is used. See: https://pkg.go.dev/time#ParseInLocation",
+		Description: fmt.Sprintf("Parse a time string using the specified format(golang time package style). If layout is null, RFC3339 format is used. If locale is given, month and weekday names(`January`, `Jan`, `Monday`, `Mon`), AM/PM markers(`PM`, `pm`) and the era(`AD`) are read in the language of the locale ignoring case. For `ja`, `平成18` and `H18` are read as the Japanese era with the year. Supported languages are `%s`. See: https://pkg.go.dev/time#ParseInLocation", strings.Join(timeLocaleNames(), "`, `")),
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:           "layout",
@@ -88,6 +107,10 @@ func (p *parseInLocation) Definition(_ context.Context, _ function.DefinitionReq
 				AllowNullValue: false,
 			},
 		},
+		VariadicParameter: function.StringParameter{
+			Name:        "locale",
+			Description: "Optional BCP 47 locale of names. like `ja-JP`, `de-DE`, `fr-FR`",
+		},
 		Return: function.StringReturn{},
 	}
 }
@@ -102,11 +125,20 @@ func (p *parseInLocation) Run(ctx context.Context, req function.RunRequest, resp
 	var layout types.String
 	var input string
 	var location string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &layout, &input, &location))
+	var locale []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &layout, &input, &location, &locale))
+	if resp.Error != nil {
+		return
+	}
 	layoutString := time.RFC3339
 	if !layout.IsNull() {
 		layoutString = layout.ValueString()
 	}
+	layoutString, input, eras, err := localizeInput(layoutString, input, locale)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, err.Error()))
+		return
+	}
 	loc, err := time.LoadLocation(location)
 	if err != nil {
 		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
@@ -117,6 +149,10 @@ func (p *parseInLocation) Run(ctx context.Context, req function.RunRequest, resp
 		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
 		return
 	}
+	if err := checkJapaneseEras(t, eras); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, timetypes.NewRFC3339TimeValue(t)))
 }
 
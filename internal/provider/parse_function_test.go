@@ -36,12 +36,32 @@ func TestParseFunction(t *testing.T) {
 				output "parsed_time_with_ANSIC" {
 					value = provider::timeconv::parse("Mon Jan _2 15:04:05 2006", "Wed Aug  7 01:23:45 2024")
 				}
+				output "parsed_time_in_french" {
+					value = provider::timeconv::parse("2 January 2006", "18 Octobre 2026", "fr-FR")
+				}
+				output "parsed_time_in_german" {
+					value = provider::timeconv::parse("Monday, 2. Jan 2006", "Sonntag, 18. Okt. 2026", "de-DE")
+				}
+				output "parsed_japanese_era" {
+					value = provider::timeconv::parse("平成18年1月2日", "令和元年5月1日", "ja")
+				}
+				output "japanese_era_round_trip" {
+					value = provider::timeconv::parse(
+						"平成18年1月2日 H18.01.02 15:04",
+						provider::timeconv::format("2026-10-18T09:30:00Z", "平成18年1月2日 H18.01.02 15:04", "ja"),
+						"ja",
+					)
+				}
 				`,
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("parsed_without_layout", knownvalue.StringExact("2024-08-31T01:23:45+09:00")),
 					statecheck.ExpectKnownOutputValue("parse_with_RFC3339", knownvalue.StringExact("2024-08-31T01:23:45+09:00")),
 					statecheck.ExpectKnownOutputValue("parsed_time", knownvalue.StringExact("2024-08-31T01:23:45+09:00")),
 					statecheck.ExpectKnownOutputValue("parsed_time_with_ANSIC", knownvalue.StringExact("2024-08-07T01:23:45Z")),
+					statecheck.ExpectKnownOutputValue("parsed_time_in_french", knownvalue.StringExact("2026-10-18T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("parsed_time_in_german", knownvalue.StringExact("2026-10-18T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("parsed_japanese_era", knownvalue.StringExact("2019-05-01T00:00:00Z")),
+					statecheck.ExpectKnownOutputValue("japanese_era_round_trip", knownvalue.StringExact("2026-10-18T09:30:00Z")),
 				},
 			},
 			{
@@ -50,6 +70,24 @@ func TestParseFunction(t *testing.T) {
 				}`,
 				ExpectError: regexp.MustCompile(`failed: parsing time`),
 			},
+			{
+				Config: `output "name_not_in_locale" {
+					value = provider::timeconv::parse("2 January 2006", "18 October 2026", "fr-FR")
+				}`,
+				ExpectError: regexp.MustCompile(`cannot\s+find\s+the\s+name`),
+			},
+			{
+				Config: `output "not_in_era" {
+					value = provider::timeconv::parse("H18.01.02", "H31.05.01", "ja")
+				}`,
+				ExpectError: regexp.MustCompile(`2019-05-01\s+is\s+not\s+in\s+平成,\s+but\s+in\s+令和`),
+			},
+			{
+				Config: `output "era_without_year" {
+					value = provider::timeconv::parse("平成 2006年1月2日", "令和 2026年10月18日", "ja")
+				}`,
+				ExpectError: regexp.MustCompile(`has\s+no\s+year\s+in\s+the\s+era`),
+			},
 		},
 	})
 }
@@ -74,11 +112,15 @@ func TestParseInLocationFunction(t *testing.T) {
 				output "parsed_time_with_ANSIC" {
 					value = provider::timeconv::parse_in_location("Mon Jan _2 15:04:05 2006", "Wed Aug  7 01:23:45 2024", "Asia/Tokyo")
 				}
+				output "parsed_time_in_japanese" {
+					value = provider::timeconv::parse_in_location("2006年1月2日(Mon) PM3時04分", "2026年10月18日(日) 午後9時30分", "Asia/Tokyo", "ja-JP")
+				}
 				`,
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("parsed_without_layout", knownvalue.StringExact("2024-08-31T01:23:45Z")),
 					statecheck.ExpectKnownOutputValue("parsed_time", knownvalue.StringExact("2024-08-31T01:23:45+09:00")),
 					statecheck.ExpectKnownOutputValue("parsed_time_with_ANSIC", knownvalue.StringExact("2024-08-07T01:23:45+09:00")),
+					statecheck.ExpectKnownOutputValue("parsed_time_in_japanese", knownvalue.StringExact("2026-10-18T21:30:00+09:00")),
 				},
 			},
 			{
@@ -5,12 +5,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
@@ -26,6 +29,16 @@ type TimeconvProvider struct {
 
 // TimeconvProviderModel describes the provider data model.
 type TimeconvProviderModel struct {
+	Now types.String `tfsdk:"now"`
+}
+
+// timeconvProviderData is passed to data sources and resources on Configure.
+type timeconvProviderData struct {
+	// clock is the clock pinned by the configuration, or the system clock.
+	clock clock
+	// runClock is pinned to the time when the provider is configured, so
+	// that all data sources in a run see the same time.
+	runClock clock
 }
 
 func (p *TimeconvProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -35,20 +48,53 @@ func (p *TimeconvProvider) Metadata(ctx context.Context, req provider.MetadataRe
 
 func (p *TimeconvProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{},
+		Attributes: map[string]schema.Attribute{
+			"now": schema.StringAttribute{
+				Optional:    true,
+				Description: "Pin the current time to RFC3339 time string, to make plans reproducible. Can also be set with `" + NOW_ENV + "` environment variable. Functions take an explicit reference time instead, since they cannot access the provider configuration.",
+			},
+		},
 	}
 }
 
 func (p *TimeconvProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config TimeconvProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clk, err := envClock()
+	if !config.Now.IsNull() && !config.Now.IsUnknown() {
+		clk, err = newClock(config.Now.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("now"),
+			"Clock configuration error",
+			"Cannot pin the current time.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+	data := &timeconvProviderData{clock: clk, runClock: fixedClock(clk.Now())}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 }
 
 func (p *TimeconvProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return nil
+	return []func() resource.Resource{
+		NewStaticTimeResource,
+		NewRotatingResource,
+		NewWaitResource,
+	}
 }
 
 func (p *TimeconvProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewTimeDataSource,
+		NewBlackoutDataSource,
 	}
 }
 
@@ -57,12 +103,46 @@ func (p *TimeconvProvider) Functions(_ context.Context) []func() function.Functi
 		NewTimezoneFunction,
 		NewFormatFunction,
 		NewAwsAtFunction,
+		NewAwsScheduleExpressionFunction,
+		NewAwsMaintenanceWindowFunction,
+		NewAwsBackupWindowFunction,
+		NewAwsWindowInLocationFunction,
+		NewAwsAutoscalingScheduleFunction,
+		NewInWindowFunction,
+		NewNextWindowFunction,
+		NewIntervalOverlapsFunction,
+		NewIntervalIntersectFunction,
+		NewIntervalUnionFunction,
+		NewIntervalContainsFunction,
+		NewIntervalGapsFunction,
 		NewZoneNameFunction,
 		NewZoneOffsetFunction,
 		NewAwsCronFunction,
 		NewUnixCronFunction,
+		NewCronLintFunction,
+		NewCronValidateFunction,
+		NewCronFromTimeFunction,
+		NewGcpScheduleFunction,
+		NewKubernetesScheduleFunction,
+		NewAzureNcrontabFunction,
+		NewQuartzCronFunction,
+		NewSpringCronFunction,
+		NewAwsCronFromQuartzFunction,
+		NewAwsCronFromSpringFunction,
+		NewSystemdCalendarFunction,
+		NewSystemdCalendarNormalizeFunction,
 		NewParseFunction,
 		NewParseInLocationFunction,
+		NewParseIntervalFunction,
+		NewExpandRepeatingIntervalFunction,
+		NewDurationParseFunction,
+		NewDurationFormatFunction,
+		NewHumanizeDurationFunction,
+		NewHumanizeRelativeFunction,
+		NewToJapaneseEraFunction,
+		NewFromJapaneseEraFunction,
+		NewCalendarConvertFunction,
+		NewCalendarParseFunction,
 	}
 }
 
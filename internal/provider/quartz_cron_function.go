@@ -0,0 +1,374 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/winebarrel/cronplan"
+)
+
+var (
+	springMacros = map[string]string{
+		"@yearly":   "0 0 0 1 1 *",
+		"@annually": "0 0 0 1 1 *",
+		"@monthly":  "0 0 0 1 * *",
+		"@weekly":   "0 0 0 * * 0",
+		"@daily":    "0 0 0 * * *",
+		"@midnight": "0 0 0 * * *",
+		"@hourly":   "0 0 * * * *",
+	}
+	springWeekdayNumber = regexp.MustCompile(`\d+`)
+	springWeekdayNames  = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}
+)
+
+// awsCronFromSecondsFields converts seconds-first cron fields(second, minute,
+// hour, day-of-month, month, day-of-week and optional year) into an
+// EventBridge cron expression. EventBridge has no seconds, so second must be 0.
+// As EventBridge requires "?" on exactly one of day-of-month and day-of-week,
+// "*" on one of them is replaced with "?".
+func awsCronFromSecondsFields(fs []string, dialect string) (*cronplan.Expression, error) {
+	if len(fs) != 6 && len(fs) != 7 {
+		return nil, fmt.Errorf("%s cron expression must have 6 or 7 fields: %q", dialect, strings.Join(fs, " "))
+	}
+	if sec, err := strconv.Atoi(fs[0]); err != nil || sec != 0 {
+		return nil, fmt.Errorf("EventBridge does not support second %q", fs[0])
+	}
+	year := "*"
+	if len(fs) == 7 {
+		year = fs[6]
+	}
+	dom, dow := fs[3], fs[5]
+	switch {
+	case dow == "*" || dow == "?":
+		dow = "?"
+		if dom == "?" {
+			dom = "*"
+		}
+	case dom == "*" || dom == "?":
+		dom = "?"
+	default:
+		return nil, fmt.Errorf("EventBridge does not support both day-of-month %q and day-of-week %q", dom, dow)
+	}
+	return cronplan.Parse(strings.Join([]string{fs[1], fs[2], dom, fs[4], dow, year}, " "))
+}
+
+// springWeekdays replaces the day-of-week numbers of Spring(0-7 from Sunday)
+// with names, which EventBridge interprets in the same way. Ranges and steps
+// are expanded into lists of names, as "*" starts from first(1 for Monday in
+// Spring, 0 for Sunday in Unix cron) while it starts from Sunday in
+// EventBridge.
+func springWeekdays(field string, first int) string {
+	items := strings.Split(field, ",")
+	for i, item := range items {
+		if days, ok := expandWeekdays(item, first); ok {
+			items[i] = days
+			continue
+		}
+		day, nth, hasNth := strings.Cut(item, "#")
+		day = springWeekdayNumber.ReplaceAllStringFunc(day, func(s string) string {
+			n, _ := strconv.Atoi(s)
+			if n < len(springWeekdayNames) {
+				return springWeekdayNames[n]
+			}
+			return s
+		})
+		if hasNth {
+			day += "#" + nth
+		}
+		items[i] = day
+	}
+	return strings.Join(items, ",")
+}
+
+// expandWeekdays expands a day-of-week range or step like "5-7", "*/2" or
+// "1/3" into a list of names. It returns false if item is neither of them.
+func expandWeekdays(item string, first int) (string, bool) {
+	head, stepText, hasStep := strings.Cut(item, "/")
+	lo, hi, isRange := strings.Cut(head, "-")
+	if !hasStep && !isRange {
+		return "", false
+	}
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepText)
+		if err != nil || n < 1 {
+			return "", false
+		}
+		step = n
+	}
+	var start, end int
+	var ok bool
+	switch {
+	case head == "*":
+		start, end, ok = first, first+6, true
+	case isRange:
+		var okHi bool
+		start, ok = weekdayNumber(lo, false)
+		end, okHi = weekdayNumber(hi, true)
+		ok = ok && okHi && start <= end
+	default:
+		start, ok = weekdayNumber(head, false)
+		end = len(springWeekdayNames) - 1
+	}
+	if !ok {
+		return "", false
+	}
+	// Ranges before Sunday as 7 are the same in EventBridge.
+	if !hasStep && end < len(springWeekdayNames)-1 {
+		return springWeekdayNames[start] + "-" + springWeekdayNames[end], true
+	}
+	days := []string{}
+	seen := map[string]bool{}
+	for n := start; n <= end; n += step {
+		if name := springWeekdayNames[n]; !seen[name] {
+			days = append(days, name)
+			seen[name] = true
+		}
+	}
+	return strings.Join(days, ","), true
+}
+
+// weekdayNumber returns the day-of-week number(0-7 from Sunday) of a number or
+// a name. Sunday by name is 7 at the end of a range.
+func weekdayNumber(s string, end bool) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, 0 <= n && n < len(springWeekdayNames)
+	}
+	for n, name := range springWeekdayNames[:7] {
+		if strings.EqualFold(s, name) {
+			if n == 0 && end {
+				n = 7
+			}
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+type quartzCron struct{}
+
+// Definition implements function.Function.
+func (q *quartzCron) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to Quartz cron expression string",
+		Description: "Convert an Amazon EventBridge cron expression to Quartz cron expression string with 7 fields(`{second} {minute} {hour} {day-of-month} {month} {day-of-week} {year}`). Quartz accepts years up to 2099. See: https://www.quartz-scheduler.org/documentation/quartz-2.3.0/tutorials/crontrigger.html",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Amazon EventBridge cron format (w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (q *quartzCron) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quartz_cron"
+}
+
+// Run implements function.Function.
+func (q *quartzCron) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+
+	expr, err := cronplan.Parse(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	if years := cronYears(expr); len(years) > 0 && years[len(years)-1] > 2099 && len(years) != 2199-1970+1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Quartz does not support year after 2099 %q", expr.Year.String())))
+		return
+	}
+	output := "0 " + expr.String()
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*quartzCron)(nil)
+
+func NewQuartzCronFunction() function.Function {
+	return &quartzCron{}
+}
+
+// springDayOfWeek returns the day-of-week field for Spring. EventBridge numbers
+// Sunday as 1 while Spring numbers Monday as 1, so steps and ranges wrapping
+// around Sunday are expanded into names of the matching days.
+func springDayOfWeek(field *cronplan.DayOfWeekField) string {
+	if field.Any {
+		return "?"
+	}
+	// spring returns the Spring number of the weekday, 7 for Sunday.
+	spring := func(w time.Weekday) int { return (int(w)+6)%7 + 1 }
+	ss := make([]string, 0, len(field.Exps))
+	for _, e := range field.Exps {
+		wraps := e.Range != nil && spring(e.Range.Start.Weekday()) > spring(e.Range.End.Weekday())
+		if e.Bottom == nil && !wraps {
+			ss = append(ss, e.String())
+			continue
+		}
+		// 2000-01-02 is Sunday.
+		for _, v := range cronFieldValues(0, 6, func(v int) bool { return e.Match(time.Date(2000, 1, 2+v, 0, 0, 0, 0, time.UTC)) }) {
+			ss = append(ss, springWeekdayNames[v])
+		}
+	}
+	return strings.Join(ss, ",")
+}
+
+type springCron struct{}
+
+// Definition implements function.Function.
+func (s *springCron) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to Spring cron expression string",
+		Description: "Convert an Amazon EventBridge cron expression to Spring cron expression string with 6 fields(`{second} {minute} {hour} {day-of-month} {month} {day-of-week}`). Spring has no year field, so the year is reported as an error. See: https://docs.spring.io/spring-framework/reference/integration/scheduling.html#scheduling-cron-expression",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Amazon EventBridge cron format (w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (s *springCron) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "spring_cron"
+}
+
+// Run implements function.Function.
+func (s *springCron) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+
+	expr, err := cronplan.Parse(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	if len(cronYears(expr)) != 2199-1970+1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Spring does not support year %q", expr.Year.String())))
+		return
+	}
+	output := fmt.Sprintf("0 %s %s %s %s %s", expr.Minute, expr.Hour, expr.DayOfMonth, expr.Month, springDayOfWeek(expr.DayOfWeek))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*springCron)(nil)
+
+func NewSpringCronFunction() function.Function {
+	return &springCron{}
+}
+
+type awsCronFromQuartz struct{}
+
+// Definition implements function.Function.
+func (a *awsCronFromQuartz) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert Quartz cron expression to AWS cron expression string(w/o \"cron(...)\")",
+		Description: "Convert Quartz cron expression with 6 or 7 fields to AWS cron expression string(w/o \"cron(...)\"). The second must be 0, and the year is `*` if omitted. See: https://www.quartz-scheduler.org/documentation/quartz-2.3.0/tutorials/crontrigger.html",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Quartz cron format",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (a *awsCronFromQuartz) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "aws_cron_from_quartz"
+}
+
+// Run implements function.Function.
+func (a *awsCronFromQuartz) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+
+	expr, err := awsCronFromSecondsFields(strings.Fields(input), "Quartz")
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, expr.String()))
+}
+
+var _ function.Function = (*awsCronFromQuartz)(nil)
+
+func NewAwsCronFromQuartzFunction() function.Function {
+	return &awsCronFromQuartz{}
+}
+
+type awsCronFromSpring struct{}
+
+// Definition implements function.Function.
+func (a *awsCronFromSpring) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert Spring cron expression to AWS cron expression string(w/o \"cron(...)\")",
+		Description: "Convert Spring cron expression with 6 fields or macro(`@daily` etc.) to AWS cron expression string(w/o \"cron(...)\"). The second must be 0, and day-of-week numbers are Spring style(0 or 7 is Sunday). See: https://docs.spring.io/spring-framework/reference/integration/scheduling.html#scheduling-cron-expression",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Spring cron format",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (a *awsCronFromSpring) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "aws_cron_from_spring"
+}
+
+// Run implements function.Function.
+func (a *awsCronFromSpring) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+
+	input = strings.TrimSpace(input)
+	if macro, ok := springMacros[strings.ToLower(input)]; ok {
+		input = macro
+	}
+	fs := strings.Fields(input)
+	if len(fs) != 6 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Spring cron expression must have 6 fields: %q", input)))
+		return
+	}
+	fs[5] = springWeekdays(fs[5], 1)
+	expr, err := awsCronFromSecondsFields(fs, "Spring")
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, expr.String()))
+}
+
+var _ function.Function = (*awsCronFromSpring)(nil)
+
+func NewAwsCronFromSpringFunction() function.Function {
+	return &awsCronFromSpring{}
+}
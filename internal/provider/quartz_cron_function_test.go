@@ -0,0 +1,180 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestQuartzCronFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "nth_weekday" {
+					value = provider::timeconv::quartz_cron("0 12 ? * 2#1 *")
+				}
+				output "with_year" {
+					value = provider::timeconv::quartz_cron("0 12 L * ? 2030")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("nth_weekday", knownvalue.StringExact("0 0 12 ? * MON#1 *")),
+					statecheck.ExpectKnownOutputValue("with_year", knownvalue.StringExact("0 0 12 L * ? 2030")),
+				},
+			},
+			{
+				Config: `output "year_after_2099" {
+					value = provider::timeconv::quartz_cron("0 12 L * ? 2020-2150")
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+year\s+after\s+2099`),
+			},
+		},
+	})
+}
+
+func TestSpringCronFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `output "spring_cron" {
+					value = provider::timeconv::spring_cron("0/15 9-17 ? * MON-FRI *")
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("spring_cron", knownvalue.StringExact("0 0/15 9-17 ? * MON-FRI")),
+				},
+			},
+			{
+				Config: `
+				output "every_other_day" {
+					value = provider::timeconv::spring_cron("0 12 ? * */2 *")
+				}
+				output "every_other_day_from_monday" {
+					value = provider::timeconv::spring_cron("0 12 ? * 2/2 *")
+				}
+				output "numbers" {
+					value = provider::timeconv::spring_cron("0 12 ? * 1,2-6 *")
+				}
+				output "wrapping_range" {
+					value = provider::timeconv::spring_cron("0 12 ? * FRI-MON *")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("every_other_day", knownvalue.StringExact("0 0 12 ? * SUN,TUE,THU,SAT")),
+					statecheck.ExpectKnownOutputValue("every_other_day_from_monday", knownvalue.StringExact("0 0 12 ? * MON,WED,FRI")),
+					statecheck.ExpectKnownOutputValue("numbers", knownvalue.StringExact("0 0 12 ? * SUN,MON-FRI")),
+					statecheck.ExpectKnownOutputValue("wrapping_range", knownvalue.StringExact("0 0 12 ? * SUN,MON,FRI,SAT")),
+				},
+			},
+			{
+				Config: `output "with_year" {
+					value = provider::timeconv::spring_cron("0 12 LW * ? 2030")
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+year\s+"2030"`),
+			},
+		},
+	})
+}
+
+func TestAwsCronFromQuartzFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "without_year" {
+					value = provider::timeconv::aws_cron_from_quartz("0 15 10 * * ?")
+				}
+				output "with_year" {
+					value = provider::timeconv::aws_cron_from_quartz("0 15 10 ? * 6L 2002-2005")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("without_year", knownvalue.StringExact("15 10 * * ? *")),
+					statecheck.ExpectKnownOutputValue("with_year", knownvalue.StringExact("15 10 ? * FRIL 2002-2005")),
+				},
+			},
+			{
+				Config: `output "with_second" {
+					value = provider::timeconv::aws_cron_from_quartz("30 15 10 * * ?")
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+second\s+"30"`),
+			},
+			{
+				Config: `output "both_days" {
+					value = provider::timeconv::aws_cron_from_quartz("0 15 10 1 * 2")
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+both\s+day-of-month`),
+			},
+		},
+	})
+}
+
+func TestAwsCronFromSpringFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "weekdays" {
+					value = provider::timeconv::aws_cron_from_spring("0 0 12 * * 1-5")
+				}
+				output "nth_weekday" {
+					value = provider::timeconv::aws_cron_from_spring("0 0 12 * * 1#2")
+				}
+				output "macro" {
+					value = provider::timeconv::aws_cron_from_spring("@daily")
+				}
+				output "every_other_day" {
+					value = provider::timeconv::aws_cron_from_spring("0 0 12 * * */2")
+				}
+				output "through_sunday" {
+					value = provider::timeconv::aws_cron_from_spring("0 0 12 * * 5-7")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("weekdays", knownvalue.StringExact("0 12 ? * MON-FRI *")),
+					statecheck.ExpectKnownOutputValue("nth_weekday", knownvalue.StringExact("0 12 ? * MON#2 *")),
+					statecheck.ExpectKnownOutputValue("macro", knownvalue.StringExact("0 0 * * ? *")),
+					statecheck.ExpectKnownOutputValue("every_other_day", knownvalue.StringExact("0 12 ? * MON,WED,FRI,SUN *")),
+					statecheck.ExpectKnownOutputValue("through_sunday", knownvalue.StringExact("0 12 ? * FRI,SAT,SUN *")),
+				},
+			},
+			{
+				Config: `output "five_fields" {
+					value = provider::timeconv::aws_cron_from_spring("0 0 12 * *")
+				}`,
+				ExpectError: regexp.MustCompile(`must\s+have\s+6\s+fields`),
+			},
+		},
+	})
+}
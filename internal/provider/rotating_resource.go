@@ -0,0 +1,192 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+const (
+	ROTATING_RS = "rotating"
+)
+
+var (
+	_ resource.Resource              = &rotatingResource{}
+	_ resource.ResourceWithConfigure = &rotatingResource{}
+)
+
+func NewRotatingResource() resource.Resource {
+	return &rotatingResource{clock: systemClock{}}
+}
+
+type rotatingResource struct {
+	clock clock
+}
+
+func (r *rotatingResource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_" + ROTATING_RS
+}
+
+func (r *rotatingResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Description: "Rotates on the schedule. The resource is planned to be replaced once the next fire time of the schedule after creation has passed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Created time in RFC3339 in UTC",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Required:    true,
+				Description: "Rotation schedule in Amazon EventBridge cron format (w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Description: "IANA timezone location of the schedule. Default is UTC.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Next fire time of the schedule after creation in RFC3339 in location",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, rotates immediately.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *rotatingResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*timeconvProviderData)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *timeconvProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.clock = data.runClock
+}
+
+func (r *rotatingResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan rotatingResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	expr, err := cronplan.Parse(plan.Schedule.ValueString())
+	if err != nil {
+		res.Diagnostics.AddError(
+			"Schedule parsing error",
+			"Cannot parse the schedule.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+	loc, err := loadScheduleLocation(plan.Location)
+	if err != nil {
+		res.Diagnostics.AddError(
+			"Location loading error",
+			"Cannot load the location.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	now := r.clock.Now()
+	// Fire times are in minutes, and the one in the current minute is not
+	// after now, so the search starts from the next minute.
+	next := expr.Next(now.In(loc).Add(time.Minute).Truncate(time.Minute))
+	if next.IsZero() {
+		res.Diagnostics.AddError(
+			"Schedule never fires",
+			fmt.Sprintf("The schedule never fires after %s: %s", now.Format(time.RFC3339), expr.String()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(now.UTC().Format(time.RFC3339))
+	plan.RotationTime = types.StringValue(next.Format(time.RFC3339))
+	diags = res.State.Set(ctx, plan)
+	res.Diagnostics.Append(diags...)
+}
+
+func (r *rotatingResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state rotatingResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	rotation, err := time.Parse(time.RFC3339, state.RotationTime.ValueString())
+	if err != nil {
+		res.Diagnostics.AddError(
+			"Rotation time parsing error",
+			"Cannot parse the rotation_time in the state.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+	// Removing the resource from the state plans to create it again.
+	if !r.clock.Now().Before(rotation) {
+		res.State.RemoveResource(ctx)
+	}
+}
+
+func (r *rotatingResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan rotatingResourceModel
+
+	// All the configurable attributes require replacement, so nothing changes.
+	diags := req.Plan.Get(ctx, &plan)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	diags = res.State.Set(ctx, plan)
+	res.Diagnostics.Append(diags...)
+}
+
+func (r *rotatingResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+}
+
+type rotatingResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Schedule     types.String `tfsdk:"schedule"`
+	Location     types.String `tfsdk:"location"`
+	RotationTime types.String `tfsdk:"rotation_time"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+}
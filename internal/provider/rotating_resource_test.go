@@ -0,0 +1,83 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRotatingResource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01T00:00:00Z"
+				}
+				resource "timeconv_rotating" "example" {
+					schedule = "0 10 ? * MON#1 *"
+					location = "Asia/Tokyo"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_rotating.example", "id", "2024-07-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("timeconv_rotating.example", "rotation_time", "2024-07-01T10:00:00+09:00"),
+				),
+			},
+			{
+				// Not rotated before the rotation time.
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01T00:59:00Z"
+				}
+				resource "timeconv_rotating" "example" {
+					schedule = "0 10 ? * MON#1 *"
+					location = "Asia/Tokyo"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_rotating.example", "id", "2024-07-01T00:00:00Z"),
+				),
+			},
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01T02:00:00Z"
+				}
+				resource "timeconv_rotating" "example" {
+					schedule = "0 10 ? * MON#1 *"
+					location = "Asia/Tokyo"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_rotating.example", "id", "2024-07-01T02:00:00Z"),
+					resource.TestCheckResourceAttr("timeconv_rotating.example", "rotation_time", "2024-08-05T10:00:00+09:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestRotatingResourceNeverFires(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01T00:00:00Z"
+				}
+				resource "timeconv_rotating" "example" {
+					schedule = "0 10 1 1 ? 2020"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`The schedule never fires after`),
+			},
+		},
+	})
+}
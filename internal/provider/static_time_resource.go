@@ -0,0 +1,261 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	STATIC_TIME_RS = "static_time"
+)
+
+var (
+	_ resource.Resource              = &staticTimeResource{}
+	_ resource.ResourceWithConfigure = &staticTimeResource{}
+)
+
+func NewStaticTimeResource() resource.Resource {
+	return &staticTimeResource{clock: systemClock{}}
+}
+
+type staticTimeResource struct {
+	clock clock
+}
+
+func (r *staticTimeResource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_" + STATIC_TIME_RS
+}
+
+func (r *staticTimeResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Description: "Captures the time once on create and keeps it until triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Captured time in RFC3339 with nanoseconds in UTC",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"input": schema.StringAttribute{
+				Optional:    true,
+				Description: "Input time string. Default is the current time on create.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Input time format. Default is RFC3339(\"2006-01-02T15:04:05Z07:00\").",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input_location": schema.StringAttribute{
+				Optional:    true,
+				Description: "Input timezone location. Default is the system localtime.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output": schema.StringAttribute{
+				Computed:    true,
+				Description: "Output time string",
+			},
+			"output_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Output time format. Default is RFC3339(\"2006-01-02T15:04:05Z07:00\")",
+			},
+			"output_location": schema.StringAttribute{
+				Optional:    true,
+				Description: "Output timezone location. Default is the system localtime.",
+			},
+			"locale": schema.StringAttribute{
+				Optional:    true,
+				Description: "BCP 47 locale of month and weekday names, AM/PM markers and the era(`AD`) in output. like `ja-JP`, `de-DE`, `fr-FR`. Default is English.",
+			},
+			"aws_cron": schema.StringAttribute{
+				Computed:    true,
+				Description: "AWS cron expression in output location.",
+			},
+			"unix": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix time in seconds",
+			},
+			"at": schema.StringAttribute{
+				Computed:    true,
+				Description: "at expression (\"at(2006-01-02T15:04:05)\")",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, captures the time again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *staticTimeResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*timeconvProviderData)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *timeconvProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.clock = data.runClock
+}
+
+func (r *staticTimeResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan staticTimeResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	t := r.clock.Now()
+	if input := plan.Input.ValueString(); input != "" {
+		inputFormat := plan.InputFormat.ValueString()
+		if inputFormat == "" {
+			inputFormat = time.RFC3339
+		}
+		loc := time.Local
+		if inputLocation := plan.InputLocation.ValueString(); inputLocation != "" {
+			if loc, err = time.LoadLocation(inputLocation); err != nil {
+				res.Diagnostics.AddError(
+					"Input location loading error",
+					"Cannot load the input_location.\n\n"+
+						fmt.Sprintf("Error: %s", err),
+				)
+				return
+			}
+		}
+		if t, err = time.ParseInLocation(inputFormat, input, loc); err != nil {
+			res.Diagnostics.AddError(
+				"Input time string parsing error",
+				"Cannot parse the input time string.\n\n"+
+					fmt.Sprintf("Error: %s", err),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(t.UTC().Format(time.RFC3339Nano))
+	res.Diagnostics.Append(plan.convert(t)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	diags = res.State.Set(ctx, plan)
+	res.Diagnostics.Append(diags...)
+}
+
+func (r *staticTimeResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	// The captured time is kept in the state only.
+}
+
+func (r *staticTimeResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan, state staticTimeResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	res.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, state.ID.ValueString())
+	if err != nil {
+		res.Diagnostics.AddError(
+			"Captured time parsing error",
+			"Cannot parse the captured time in the state.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	res.Diagnostics.Append(plan.convert(t)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	diags = res.State.Set(ctx, plan)
+	res.Diagnostics.Append(diags...)
+}
+
+func (r *staticTimeResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+}
+
+type staticTimeResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Input          types.String `tfsdk:"input"`
+	InputFormat    types.String `tfsdk:"input_format"`
+	InputLocation  types.String `tfsdk:"input_location"`
+	Output         types.String `tfsdk:"output"`
+	OutputFormat   types.String `tfsdk:"output_format"`
+	OutputLocation types.String `tfsdk:"output_location"`
+	Locale         types.String `tfsdk:"locale"`
+	AwsCron        types.String `tfsdk:"aws_cron"`
+	Unix           types.Int64  `tfsdk:"unix"`
+	At             types.String `tfsdk:"at"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+}
+
+// convert sets the conversions of t in the output location, format and
+// locale.
+func (m *staticTimeResourceModel) convert(t time.Time) (diags diag.Diagnostics) {
+	outputFormat := m.OutputFormat.ValueString()
+	if outputFormat == "" {
+		outputFormat = time.RFC3339
+	}
+	outloc := time.Local
+	if outputLocation := m.OutputLocation.ValueString(); outputLocation != "" {
+		var err error
+		if outloc, err = time.LoadLocation(outputLocation); err != nil {
+			diags.AddError(
+				"Output location loading error",
+				"Cannot load the output_location.\n\n"+
+					fmt.Sprintf("Error: %s", err),
+			)
+			return diags
+		}
+	}
+
+	out := t.In(outloc)
+	output, err := formatWithLocale(out, outputFormat, m.Locale)
+	if err != nil {
+		diags.AddError(
+			"Output formatting error",
+			"Cannot format the output in the locale.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return diags
+	}
+	m.Output = types.StringValue(output)
+	m.AwsCron = types.StringValue(cron(out))
+	m.Unix = types.Int64Value(out.Unix())
+	m.At = types.StringValue(at(out))
+	return diags
+}
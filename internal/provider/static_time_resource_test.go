@@ -0,0 +1,129 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestStaticTimeResource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "timeconv_static_time" "example" {
+					input = "2023-02-15T16:35:00+09:00"
+					output_location = "America/Los_Angeles"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "id", "2023-02-15T07:35:00Z"),
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "output", "2023-02-14T23:35:00-08:00"),
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "aws_cron", "35 23 14 2 ? 2023"),
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "unix", "1676446500"),
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "at", "at(2023-02-15T07:35:00)"),
+				),
+			},
+		},
+	})
+}
+
+func TestStaticTimeResourceLocale(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "timeconv_static_time" "example" {
+					input = "2023-02-15T16:35:00+09:00"
+					output_format = "Monday 2 January 2006"
+					output_location = "Europe/Berlin"
+					locale = "de-DE"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "output", "Mittwoch 15 Februar 2023"),
+				),
+			},
+			{
+				// Changing the locale updates output in place.
+				Config: `
+				resource "timeconv_static_time" "example" {
+					input = "2023-02-15T16:35:00+09:00"
+					output_format = "Monday 2 January 2006"
+					output_location = "Europe/Berlin"
+					locale = "fr-FR"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "id", "2023-02-15T07:35:00Z"),
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "output", "mercredi 15 février 2023"),
+				),
+			},
+		},
+	})
+}
+
+func TestStaticTimeResourceNow(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01T00:00:00Z"
+				}
+				resource "timeconv_static_time" "example" {
+					output_location = "UTC"
+					triggers = {
+						version = "1"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "id", "2024-07-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "output", "2024-07-01T00:00:00Z"),
+				),
+			},
+			{
+				// The captured time is kept while triggers are unchanged.
+				Config: `
+				provider "timeconv" {
+					now = "2024-08-01T00:00:00Z"
+				}
+				resource "timeconv_static_time" "example" {
+					output_location = "Asia/Tokyo"
+					triggers = {
+						version = "1"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "id", "2024-07-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "output", "2024-07-01T09:00:00+09:00"),
+				),
+			},
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-08-01T00:00:00Z"
+				}
+				resource "timeconv_static_time" "example" {
+					output_location = "Asia/Tokyo"
+					triggers = {
+						version = "2"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "id", "2024-08-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("timeconv_static_time.example", "output", "2024-08-01T09:00:00+09:00"),
+				),
+			},
+		},
+	})
+}
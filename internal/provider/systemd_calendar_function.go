@@ -0,0 +1,492 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+var (
+	// systemdWeekdayNames is indexed by time.Weekday.
+	systemdWeekdayNames = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	systemdShorthands   = map[string]string{
+		"minutely":     "*-*-* *:*:00",
+		"hourly":       "*-*-* *:00:00",
+		"daily":        "*-*-* 00:00:00",
+		"monthly":      "*-*-01 00:00:00",
+		"weekly":       "Mon *-*-* 00:00:00",
+		"yearly":       "*-01-01 00:00:00",
+		"annually":     "*-01-01 00:00:00",
+		"quarterly":    "*-01,04,07,10-01 00:00:00",
+		"semiannually": "*-01,07-01 00:00:00",
+	}
+)
+
+// systemdCalendar is a systemd calendar event split into its components.
+// Each component is already normalised.
+type systemdCalendar struct {
+	weekdays string
+	year     string
+	month    string
+	day      string
+	hour     string
+	minute   string
+	second   string
+	location string
+}
+
+func (c *systemdCalendar) String() string {
+	var b strings.Builder
+	if c.weekdays != "" {
+		b.WriteString(c.weekdays + " ")
+	}
+	sep := "-"
+	if strings.HasPrefix(c.day, "~") {
+		sep = ""
+	}
+	fmt.Fprintf(&b, "%s-%s%s%s %s:%s:%s", c.year, c.month, sep, c.day, c.hour, c.minute, c.second)
+	if c.location != "" {
+		b.WriteString(" " + c.location)
+	}
+	return b.String()
+}
+
+// formatCalendarField renders values between lo and hi as a systemd calendar
+// component padded to width digits.
+func formatCalendarField(values []int, lo, hi, width int) string {
+	if len(values) == hi-lo+1 {
+		return "*"
+	}
+	if len(values) >= 3 {
+		step := values[1] - values[0]
+		progression := step > 1
+		for i := 2; progression && i < len(values); i++ {
+			progression = values[i]-values[i-1] == step
+		}
+		if progression && values[len(values)-1]+step > hi {
+			return fmt.Sprintf("%0*d/%d", width, values[0], step)
+		}
+	}
+	ss := []string{}
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j > i {
+			ss = append(ss, fmt.Sprintf("%0*d..%0*d", width, values[i], width, values[j]))
+		} else {
+			ss = append(ss, fmt.Sprintf("%0*d", width, values[i]))
+		}
+		i = j + 1
+	}
+	return strings.Join(ss, ",")
+}
+
+// formatCalendarWeekdays renders weekdays(time.Weekday values) in the order
+// from Monday to Sunday as systemd does. It returns "" for every day.
+func formatCalendarWeekdays(values []int) string {
+	if len(values) == 7 {
+		return ""
+	}
+	set := map[int]bool{}
+	for _, v := range values {
+		set[(v+6)%7] = true
+	}
+	ss := []string{}
+	for i := 0; i < 7; {
+		if !set[i] {
+			i++
+			continue
+		}
+		j := i
+		for j+1 < 7 && set[j+1] {
+			j++
+		}
+		start, end := systemdWeekdayNames[(i+1)%7], systemdWeekdayNames[(j+1)%7]
+		switch {
+		case j == i:
+			ss = append(ss, start)
+		case j == i+1:
+			ss = append(ss, start, end)
+		default:
+			ss = append(ss, start+".."+end)
+		}
+		i = j + 1
+	}
+	return strings.Join(ss, ",")
+}
+
+// parseCalendarField parses a systemd calendar component with values between
+// lo and hi into the matched values.
+func parseCalendarField(field string, lo, hi int) ([]int, error) {
+	set := map[int]bool{}
+	for _, item := range strings.Split(field, ",") {
+		head, stepString, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepString); err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid repetition %q", item)
+			}
+		}
+		start, end := lo, hi
+		if head != "*" {
+			from, to, isRange := strings.Cut(head, "..")
+			var err error
+			if start, err = strconv.Atoi(from); err != nil {
+				return nil, fmt.Errorf("invalid value %q", item)
+			}
+			end = start
+			if hasStep {
+				end = hi
+			}
+			if isRange {
+				if end, err = strconv.Atoi(to); err != nil {
+					return nil, fmt.Errorf("invalid value %q", item)
+				}
+			}
+		}
+		if start < lo || end > hi || start > end {
+			return nil, fmt.Errorf("value %q must be %d-%d", item, lo, hi)
+		}
+		for v := start; v <= end; v += step {
+			set[v] = true
+		}
+	}
+	return cronFieldValues(lo, hi, func(v int) bool { return set[v] }), nil
+}
+
+// parseCalendarWeekdays parses the weekday component of a systemd calendar
+// event into time.Weekday values. Ranges are from Monday to Sunday.
+func parseCalendarWeekdays(field string) ([]int, error) {
+	// lookup returns the index of name counted from Monday.
+	lookup := func(name string) (int, error) {
+		for i := range systemdWeekdayNames {
+			if len(name) >= 3 && strings.HasPrefix(strings.ToLower(time.Weekday(i).String()), strings.ToLower(name)) {
+				return (i + 6) % 7, nil
+			}
+		}
+		return 0, fmt.Errorf("invalid weekday %q", name)
+	}
+	set := map[int]bool{}
+	for _, item := range strings.Split(field, ",") {
+		from, to, isRange := strings.Cut(item, "..")
+		if !isRange {
+			from, to, isRange = strings.Cut(item, "-")
+		}
+		start, err := lookup(from)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if isRange {
+			if end, err = lookup(to); err != nil {
+				return nil, err
+			}
+		}
+		if start > end {
+			return nil, fmt.Errorf("invalid weekday range %q", item)
+		}
+		for i := start; i <= end; i++ {
+			set[(i+1)%7] = true
+		}
+	}
+	return cronFieldValues(0, 6, func(v int) bool { return set[v] }), nil
+}
+
+// parseSystemdCalendar parses a systemd calendar event(OnCalendar=).
+func parseSystemdCalendar(input string) (*systemdCalendar, error) {
+	input = strings.TrimSpace(input)
+	if shorthand, ok := systemdShorthands[strings.ToLower(input)]; ok {
+		input = shorthand
+	}
+	tokens := strings.Fields(input)
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty calendar event")
+	}
+	c := &systemdCalendar{}
+	date, clock := "*-*-*", "00:00:00"
+	if last := tokens[len(tokens)-1]; len(tokens) > 1 && unicode.IsLetter(rune(last[0])) {
+		loc, err := time.LoadLocation(last)
+		if err != nil || loc == time.Local {
+			return nil, fmt.Errorf("invalid timezone %q", last)
+		}
+		c.location = loc.String()
+		tokens = tokens[:len(tokens)-1]
+	}
+	if len(tokens) > 0 && unicode.IsLetter(rune(tokens[0][0])) {
+		first := tokens[0]
+		values, err := parseCalendarWeekdays(first)
+		if err != nil {
+			return nil, err
+		}
+		c.weekdays = formatCalendarWeekdays(values)
+		tokens = tokens[1:]
+	}
+	for _, token := range tokens {
+		switch {
+		case strings.Contains(token, ":"):
+			clock = token
+		case strings.ContainsAny(token, "-~"):
+			date = token
+		default:
+			return nil, fmt.Errorf("invalid calendar event component %q", token)
+		}
+	}
+
+	var err error
+	var values []int
+	dateParts := strings.Split(date, "-")
+	if before, after, ok := strings.Cut(date, "~"); ok {
+		dateParts = append(strings.Split(before, "-"), "~"+after)
+	}
+	if len(dateParts) == 2 {
+		dateParts = append([]string{"*"}, dateParts...)
+	}
+	if len(dateParts) != 3 {
+		return nil, fmt.Errorf("invalid date %q", date)
+	}
+	if values, err = parseCalendarField(dateParts[0], 1970, 2199); err != nil {
+		return nil, fmt.Errorf("invalid year: %w", err)
+	}
+	c.year = formatCalendarField(values, 1970, 2199, 4)
+	if values, err = parseCalendarField(dateParts[1], 1, 12); err != nil {
+		return nil, fmt.Errorf("invalid month: %w", err)
+	}
+	c.month = formatCalendarField(values, 1, 12, 2)
+	if last, ok := strings.CutPrefix(dateParts[2], "~"); ok {
+		// The repetition of days from the end of month goes toward the end,
+		// so it is kept as it is.
+		from, step, hasStep := strings.Cut(last, "/")
+		if values, err = parseCalendarField(from, 1, 31); err != nil {
+			return nil, fmt.Errorf("invalid day: %w", err)
+		}
+		c.day = "~" + formatCalendarField(values, 1, 31, 2)
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 || len(values) != 1 {
+				return nil, fmt.Errorf("invalid day: invalid repetition %q", last)
+			}
+			c.day += "/" + strconv.Itoa(n)
+		}
+	} else {
+		if values, err = parseCalendarField(dateParts[2], 1, 31); err != nil {
+			return nil, fmt.Errorf("invalid day: %w", err)
+		}
+		c.day = formatCalendarField(values, 1, 31, 2)
+	}
+
+	clockParts := strings.Split(clock, ":")
+	if len(clockParts) == 2 {
+		clockParts = append(clockParts, "00")
+	}
+	if len(clockParts) != 3 {
+		return nil, fmt.Errorf("invalid time %q", clock)
+	}
+	if values, err = parseCalendarField(clockParts[0], 0, 23); err != nil {
+		return nil, fmt.Errorf("invalid hour: %w", err)
+	}
+	c.hour = formatCalendarField(values, 0, 23, 2)
+	if values, err = parseCalendarField(clockParts[1], 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid minute: %w", err)
+	}
+	c.minute = formatCalendarField(values, 0, 59, 2)
+	if values, err = parseCalendarField(clockParts[2], 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid second: %w", err)
+	}
+	c.second = formatCalendarField(values, 0, 59, 2)
+	return c, nil
+}
+
+// parseAwsOrUnixCron parses an EventBridge cron expression, or a Unix cron
+// expression with 5 fields whose day-of-week numbers are from 0(or 7) as
+// Sunday.
+func parseAwsOrUnixCron(input string) (*cronplan.Expression, error) {
+	fs := strings.Fields(input)
+	if len(fs) != 5 {
+		return cronplan.Parse(input)
+	}
+	dom, dow := fs[2], springWeekdays(fs[4], 0)
+	switch {
+	case dow == "*":
+		dow = "?"
+	case dom == "*":
+		dom = "?"
+	default:
+		return nil, fmt.Errorf("both day-of-month %q and day-of-week %q are not supported", dom, dow)
+	}
+	return cronplan.Parse(strings.Join([]string{fs[0], fs[1], dom, fs[3], dow, "*"}, " "))
+}
+
+// systemdCalendarFromCron converts expr into a systemd calendar event.
+func systemdCalendarFromCron(expr *cronplan.Expression) (*systemdCalendar, error) {
+	c := &systemdCalendar{second: "00"}
+	fields := []struct {
+		name      string
+		lo, hi, w int
+		match     func(int) bool
+		dst       *string
+	}{
+		{"minute", 0, 59, 2, func(v int) bool { return expr.Minute.Match(time.Date(2000, 1, 1, 0, v, 0, 0, time.UTC)) }, &c.minute},
+		{"hour", 0, 23, 2, func(v int) bool { return expr.Hour.Match(time.Date(2000, 1, 1, v, 0, 0, 0, time.UTC)) }, &c.hour},
+		{"month", 1, 12, 2, func(v int) bool { return expr.Month.Match(time.Date(2000, time.Month(v), 1, 0, 0, 0, 0, time.UTC)) }, &c.month},
+	}
+	for _, f := range fields {
+		values := cronFieldValues(f.lo, f.hi, f.match)
+		if len(values) == 0 {
+			return nil, fmt.Errorf("%s field never matches: %s", f.name, expr.String())
+		}
+		*f.dst = formatCalendarField(values, f.lo, f.hi, f.w)
+	}
+	c.year = formatCalendarField(cronYears(expr), 1970, 2199, 4)
+
+	c.day = "*"
+	switch {
+	case !expr.DayOfMonth.Any && len(expr.DayOfMonth.Exps) == 1 && expr.DayOfMonth.Exps[0].Last != nil:
+		c.day = fmt.Sprintf("~%02d", expr.DayOfMonth.Exps[0].Last.Int()+1)
+	case !expr.DayOfMonth.Any:
+		for _, e := range expr.DayOfMonth.Exps {
+			if e.NearestWeekday != nil || e.LastWeekday != nil || e.Last != nil {
+				return nil, fmt.Errorf("systemd does not support day-of-month %q", e.String())
+			}
+		}
+		values := cronFieldValues(1, 31, func(v int) bool { return expr.DayOfMonth.Match(time.Date(2000, 1, v, 0, 0, 0, 0, time.UTC)) })
+		c.day = formatCalendarField(values, 1, 31, 2)
+	case len(expr.DayOfWeek.Exps) == 1 && expr.DayOfWeek.Exps[0].Nth != nil:
+		nth := expr.DayOfWeek.Exps[0].Nth
+		c.weekdays = systemdWeekdayNames[nth.Wday.Int()]
+		c.day = fmt.Sprintf("%02d..%02d", nth.Nth*7-6, min(nth.Nth*7, 31))
+	case len(expr.DayOfWeek.Exps) == 1 && expr.DayOfWeek.Exps[0].Last != nil && expr.DayOfWeek.Exps[0].Last.Wday != nil:
+		c.weekdays = systemdWeekdayNames[expr.DayOfWeek.Exps[0].Last.Wday.Int()]
+		c.day = "~07/1"
+	default:
+		for _, e := range expr.DayOfWeek.Exps {
+			if e.Nth != nil || (e.Last != nil && e.Last.Wday != nil) {
+				return nil, fmt.Errorf("systemd does not support day-of-week %q in a list", e.String())
+			}
+		}
+		// 2000-01-02 is Sunday.
+		values := cronFieldValues(0, 6, func(v int) bool { return expr.DayOfWeek.Match(time.Date(2000, 1, 2+v, 0, 0, 0, 0, time.UTC)) })
+		c.weekdays = formatCalendarWeekdays(values)
+	}
+	return c, nil
+}
+
+type systemdCalendarFunction struct{}
+
+// Definition implements function.Function.
+func (s *systemdCalendarFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to systemd calendar event(OnCalendar=) string",
+		Description: "Convert an Amazon EventBridge cron expression or a Unix cron expression with 5 fields to systemd calendar event string with timezone suffix for `OnCalendar=`. If location is null, UTC is used. See: https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input time string in Amazon EventBridge cron format (w/o \"cron(...)\") or Unix cron format",
+				AllowNullValue: false,
+			},
+			function.StringParameter{
+				Name:           "location",
+				Description:    "IANA timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (s *systemdCalendarFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "systemd_calendar"
+}
+
+// Run implements function.Function.
+func (s *systemdCalendarFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var location types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &location))
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := parseAwsOrUnixCron(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	c, err := systemdCalendarFromCron(expr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	c.location = loc.String()
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, c.String()))
+}
+
+var _ function.Function = (*systemdCalendarFunction)(nil)
+
+func NewSystemdCalendarFunction() function.Function {
+	return &systemdCalendarFunction{}
+}
+
+type systemdCalendarNormalize struct{}
+
+// Definition implements function.Function.
+func (s *systemdCalendarNormalize) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Validate and normalise systemd calendar event(OnCalendar=) string",
+		Description: "Validate systemd calendar event string and return its normalized form like `systemd-analyze calendar`. Shorthands(`daily`, `weekly` etc.) are expanded. See: https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:           "input",
+				Description:    "Input systemd calendar event string",
+				AllowNullValue: false,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (s *systemdCalendarNormalize) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "systemd_calendar_normalize"
+}
+
+// Run implements function.Function.
+func (s *systemdCalendarNormalize) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+
+	c, err := parseSystemdCalendar(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, c.String()))
+}
+
+var _ function.Function = (*systemdCalendarNormalize)(nil)
+
+func NewSystemdCalendarNormalizeFunction() function.Function {
+	return &systemdCalendarNormalize{}
+}
@@ -0,0 +1,116 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSystemdCalendarFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "business_hours" {
+					value = provider::timeconv::systemd_calendar("0/15 9-17 ? * MON-FRI *", "Asia/Tokyo")
+				}
+				output "last_day" {
+					value = provider::timeconv::systemd_calendar("30 3 L * ? *", null)
+				}
+				output "nth_weekday" {
+					value = provider::timeconv::systemd_calendar("0 10 ? * 2#1 *", "Asia/Tokyo")
+				}
+				output "last_weekday" {
+					value = provider::timeconv::systemd_calendar("0 10 ? * 6L *", "Asia/Tokyo")
+				}
+				output "unix_cron" {
+					value = provider::timeconv::systemd_calendar("*/5 * * * 1-5", "Asia/Tokyo")
+				}
+				output "unix_cron_step" {
+					value = provider::timeconv::systemd_calendar("0 12 * * */2", null)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("business_hours", knownvalue.StringExact("Mon..Fri *-*-* 09..17:00/15:00 Asia/Tokyo")),
+					statecheck.ExpectKnownOutputValue("last_day", knownvalue.StringExact("*-*~01 03:30:00 UTC")),
+					statecheck.ExpectKnownOutputValue("nth_weekday", knownvalue.StringExact("Mon *-*-01..07 10:00:00 Asia/Tokyo")),
+					statecheck.ExpectKnownOutputValue("last_weekday", knownvalue.StringExact("Fri *-*~07/1 10:00:00 Asia/Tokyo")),
+					statecheck.ExpectKnownOutputValue("unix_cron", knownvalue.StringExact("Mon..Fri *-*-* *:00/5:00 Asia/Tokyo")),
+					statecheck.ExpectKnownOutputValue("unix_cron_step", knownvalue.StringExact("Tue,Thu,Sat,Sun *-*-* 12:00:00 UTC")),
+				},
+			},
+			{
+				Config: `output "nearest_weekday" {
+					value = provider::timeconv::systemd_calendar("0 0 15W * ? *", null)
+				}`,
+				ExpectError: regexp.MustCompile(`does\s+not\s+support\s+day-of-month\s+"15W"`),
+			},
+			{
+				Config: `output "both_days" {
+					value = provider::timeconv::systemd_calendar("0 0 1 * 0", null)
+				}`,
+				ExpectError: regexp.MustCompile(`are\s+not\s+supported`),
+			},
+		},
+	})
+}
+
+func TestSystemdCalendarNormalizeFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "shorthand" {
+					value = provider::timeconv::systemd_calendar_normalize("daily")
+				}
+				output "short_time" {
+					value = provider::timeconv::systemd_calendar_normalize("Mon..Fri 9:0")
+				}
+				output "with_timezone" {
+					value = provider::timeconv::systemd_calendar_normalize("Sat,Sun *-*-* 10:00 Europe/Berlin")
+				}
+				output "last_monday_of_may" {
+					value = provider::timeconv::systemd_calendar_normalize("Mon *-05~07/1")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("shorthand", knownvalue.StringExact("*-*-* 00:00:00")),
+					statecheck.ExpectKnownOutputValue("short_time", knownvalue.StringExact("Mon..Fri *-*-* 09:00:00")),
+					statecheck.ExpectKnownOutputValue("with_timezone", knownvalue.StringExact("Sat,Sun *-*-* 10:00:00 Europe/Berlin")),
+					statecheck.ExpectKnownOutputValue("last_monday_of_may", knownvalue.StringExact("Mon *-05~07/1 00:00:00")),
+				},
+			},
+			{
+				Config: `output "invalid_month" {
+					value = provider::timeconv::systemd_calendar_normalize("*-13-01")
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+month`),
+			},
+			{
+				Config: `output "invalid_timezone" {
+					value = provider::timeconv::systemd_calendar_normalize("12:00 Bad/Zone")
+				}`,
+				ExpectError: regexp.MustCompile(`invalid\s+timezone`),
+			},
+		},
+	})
+}
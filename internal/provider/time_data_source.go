@@ -23,10 +23,13 @@ var (
 )
 
 func NewTimeDataSource() datasource.DataSource {
-	return &timeDataSource{}
+	return &timeDataSource{clock: systemClock{}, runClock: systemClock{}}
 }
 
-type timeDataSource struct{}
+type timeDataSource struct {
+	clock    clock
+	runClock clock
+}
 
 func (d *timeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
 	res.TypeName = req.ProviderTypeName + "_" + TIME_DS
@@ -37,7 +40,15 @@ func (d *timeDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
 		Attributes: map[string]schema.Attribute{
 			"input": schema.StringAttribute{
 				Optional:    true,
-				Description: "Input time string",
+				Description: "Input time string. Default is the current time, which can be pinned with the provider `now` attribute.",
+			},
+			"now_granularity": schema.StringAttribute{
+				Optional:    true,
+				Description: "Floor the current time to the last boundary in output_location when input is empty, so that it changes only when the boundary is crossed. Duration(golang time package style plus `d` for days, like `1h`, `1d`. `7d` starts on Monday) or Amazon EventBridge or Unix cron expression.",
+			},
+			"realtime": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the current time is taken on each read. Default is false, the time when the provider is configured is shared by all data sources in a run.",
 			},
 			"input_format": schema.StringAttribute{
 				Optional:    true,
@@ -59,10 +70,22 @@ func (d *timeDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
 				Optional:    true,
 				Description: "Output timezone location. Default is the system localtime.",
 			},
+			"locale": schema.StringAttribute{
+				Optional:    true,
+				Description: "BCP 47 locale of month and weekday names, AM/PM markers and the era(`AD`) in output. like `ja-JP`, `de-DE`, `fr-FR`. Default is English.",
+			},
+			"recurrence": schema.StringAttribute{
+				Optional:    true,
+				Description: "Recurrence of aws_cron and unix_cron. `daily`, `weekly`, `monthly` or `yearly`. Default is none, aws_cron fires only once.",
+			},
 			"aws_cron": schema.StringAttribute{
 				Computed:    true,
 				Description: "AWS cron expression in output location.",
 			},
+			"unix_cron": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unix cron expression in output location. Null unless recurrence is set.",
+			},
 			"cron": schema.StringAttribute{
 				Computed:           true,
 				DeprecationMessage: "You should use aws_cron instead of this",
@@ -90,7 +113,10 @@ func (d *timeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	var err error
-	t := time.Now()
+	t := d.runClock.Now()
+	if config.Realtime.ValueBool() {
+		t = d.clock.Now()
+	}
 	loc := time.Local
 
 	inputFormat := config.InputFormat.ValueString()
@@ -139,19 +165,58 @@ func (d *timeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	}
 
+	if granularity := config.NowGranularity.ValueString(); input == "" && granularity != "" {
+		if t, err = floorTime(t, granularity, outloc); err != nil {
+			res.Diagnostics.AddError(
+				"Now granularity error",
+				"Cannot floor the current time by now_granularity.\n\n"+
+					fmt.Sprintf("Error: %s", err),
+			)
+			return
+		}
+	}
+
 	out := t.In(outloc)
+	output, err := formatWithLocale(out, outputFormat, config.Locale)
+	if err != nil {
+		res.Diagnostics.AddError(
+			"Output formatting error",
+			"Cannot format the output in the locale.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	awsCron, unixCron := types.StringValue(cron(out)), types.StringNull()
+	if recurrence := config.Recurrence.ValueString(); recurrence != "" {
+		aws, unix, err := recurringCron(out, recurrence)
+		if err != nil {
+			res.Diagnostics.AddError(
+				"Recurrence error",
+				"Cannot derive the recurring cron expression.\n\n"+
+					fmt.Sprintf("Error: %s", err),
+			)
+			return
+		}
+		awsCron, unixCron = types.StringValue(aws), types.StringValue(unix)
+	}
 
 	state := timeDataSourceModel{
 		input:          t,
 		output:         out,
 		Input:          config.Input,
+		NowGranularity: config.NowGranularity,
+		Realtime:       config.Realtime,
 		InputFormat:    types.StringValue(inputFormat),
 		InputLocation:  types.StringValue(inputLocation),
-		Output:         types.StringValue(out.Format(outputFormat)),
+		Output:         types.StringValue(output),
 		OutputFormat:   types.StringValue(outputFormat),
 		OutputLocation: types.StringValue(outputLocation),
-		AwsCron:        types.StringValue(cron(out)),
-		Cron:           types.StringValue(cron(out)),
+		Locale:         config.Locale,
+		Recurrence:     config.Recurrence,
+		AwsCron:        awsCron,
+		Cron:           awsCron,
+		UnixCron:       unixCron,
 		Unix:           types.Int64Value(out.Unix()),
 		At:             types.StringValue(at(out)),
 	}
@@ -160,18 +225,35 @@ func (d *timeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 }
 
 func (d *timeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*timeconvProviderData)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *timeconvProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.clock, d.runClock = data.clock, data.runClock
 }
 
 type timeDataSourceModel struct {
 	input          time.Time
 	output         time.Time
 	Input          types.String `tfsdk:"input"`
+	NowGranularity types.String `tfsdk:"now_granularity"`
+	Realtime       types.Bool   `tfsdk:"realtime"`
 	InputFormat    types.String `tfsdk:"input_format"`
 	InputLocation  types.String `tfsdk:"input_location"`
 	Output         types.String `tfsdk:"output"`
 	OutputFormat   types.String `tfsdk:"output_format"`
 	OutputLocation types.String `tfsdk:"output_location"`
+	Locale         types.String `tfsdk:"locale"`
+	Recurrence     types.String `tfsdk:"recurrence"`
 	AwsCron        types.String `tfsdk:"aws_cron"`
+	UnixCron       types.String `tfsdk:"unix_cron"`
 	Cron           types.String `tfsdk:"cron"`
 	Unix           types.Int64  `tfsdk:"unix"`
 	At             types.String `tfsdk:"at"`
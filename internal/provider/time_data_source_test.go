@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -61,6 +62,118 @@ func TestTimeDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.timeconv_time.example", "at", "at(2023-02-14T22:36:05)"),
 				),
 			},
+			{
+				Config: `
+				data "timeconv_time" "example" {
+					input = "2023-02-15T16:35:00+09:00"
+					output_format = "2006年1月2日(Mon) PM3時04分"
+					output_location = "Asia/Tokyo"
+					locale = "ja-JP"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.timeconv_time.example", "output", "2023年2月15日(水) 午後4時35分"),
+					resource.TestCheckResourceAttr("data.timeconv_time.example", "locale", "ja-JP"),
+				),
+			},
+			{
+				Config: `
+				data "timeconv_time" "example" {
+					input = "2023-02-15T16:35:00+09:00"
+					locale = "xx"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unsupported\s+locale`),
+			},
+			{
+				Config: `
+				data "timeconv_time" "example" {
+					input = "2023-02-15T16:35:00+09:00"
+					output_location = "America/Los_Angeles"
+					recurrence = "weekly"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.timeconv_time.example", "aws_cron", "35 23 ? * TUE *"),
+					resource.TestCheckResourceAttr("data.timeconv_time.example", "cron", "35 23 ? * TUE *"),
+					resource.TestCheckResourceAttr("data.timeconv_time.example", "unix_cron", "35 23 * * 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestTimeDataSourceFixedClock(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01T09:00:00+09:00"
+				}
+				data "timeconv_time" "example" {
+					output_location = "UTC"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.timeconv_time.example", "output", "2024-07-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.timeconv_time.example", "unix", "1719792000"),
+				),
+			},
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-03T16:47:12+09:00"
+				}
+				data "timeconv_time" "daily" {
+					now_granularity = "1d"
+					output_location = "Asia/Tokyo"
+				}
+				data "timeconv_time" "cron" {
+					now_granularity = "0 9 ? * MON *"
+					output_location = "Asia/Tokyo"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.timeconv_time.daily", "output", "2024-07-03T00:00:00+09:00"),
+					resource.TestCheckResourceAttr("data.timeconv_time.cron", "output", "2024-07-01T09:00:00+09:00"),
+				),
+			},
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01 09:00:00"
+				}
+				data "timeconv_time" "example" {}
+				`,
+				ExpectError: regexp.MustCompile(`now must be RFC3339 time string`),
+			},
+		},
+	})
+}
+
+func TestTimeDataSourceRunClock(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "timeconv_time" "first" {
+					output_format = "2006-01-02T15:04:05.000000000Z07:00"
+				}
+				data "timeconv_time" "second" {
+					output_format = "2006-01-02T15:04:05.000000000Z07:00"
+				}
+				data "timeconv_time" "realtime" {
+					realtime = true
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.timeconv_time.first", "output", "data.timeconv_time.second", "output"),
+					resource.TestCheckResourceAttrSet("data.timeconv_time.realtime", "output"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,298 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+const (
+	WAIT_RS = "wait"
+)
+
+var (
+	_ resource.Resource                     = &waitResource{}
+	_ resource.ResourceWithConfigure        = &waitResource{}
+	_ resource.ResourceWithConfigValidators = &waitResource{}
+	_ resource.ResourceWithValidateConfig   = &waitResource{}
+)
+
+func NewWaitResource() resource.Resource {
+	return &waitResource{clock: systemClock{}}
+}
+
+type waitResource struct {
+	clock clock
+}
+
+func (r *waitResource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_" + WAIT_RS
+}
+
+func (r *waitResource) Schema(_ context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Description: "Waits on create and update until the time or the next fire time of the schedule. Exactly one of until and schedule must be set. The wait is measured by the system clock, and the pinned `now` of the provider is used only with dry_run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Created time in RFC3339 in UTC",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"until": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time string in RFC3339 format to wait until",
+			},
+			"schedule": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schedule in Amazon EventBridge cron format (w/o \"cron(...)\") to wait for the next fire time of. See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Description: "IANA timezone location of the schedule. Default is UTC.",
+			},
+			"max_wait": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("1h"),
+				Description: "Maximum duration to wait(golang time package style), which must not be negative. It is an error if the target time is later than this from now. Default is `1h`.",
+			},
+			"dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If true, computes target_time from the pinned `now` of the provider(or the system clock) without waiting. Default is false.",
+			},
+			"target_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time waited until in RFC3339",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, waits again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *waitResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*timeconvProviderData)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *timeconvProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	// Waiting needs the time at apply, not the one when configured.
+	r.clock = data.clock
+}
+
+func (r *waitResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("until"), path.MatchRoot("schedule")),
+	}
+}
+
+func (r *waitResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var maxWait types.String
+
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_wait"), &maxWait)...)
+	if res.Diagnostics.HasError() || maxWait.IsNull() || maxWait.IsUnknown() {
+		return
+	}
+	d, err := time.ParseDuration(maxWait.ValueString())
+	if err != nil {
+		res.Diagnostics.AddAttributeError(
+			path.Root("max_wait"),
+			"Max wait parsing error",
+			"Cannot parse the max_wait.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+	if d < 0 {
+		res.Diagnostics.AddAttributeError(
+			path.Root("max_wait"),
+			"Negative max wait",
+			fmt.Sprintf("The max_wait must not be negative: %s", maxWait.ValueString()),
+		)
+	}
+}
+
+// targetTime returns the time to wait until from now for m.
+func (m *waitResourceModel) targetTime(now time.Time) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.Until.IsNull() == m.Schedule.IsNull() {
+		diags.AddError(
+			"Invalid wait configuration",
+			"Exactly one of until and schedule must be set.",
+		)
+		return time.Time{}, diags
+	}
+	if !m.Until.IsNull() {
+		t, err := time.Parse(time.RFC3339, m.Until.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Until parsing error",
+				"Cannot parse the until time string.\n\n"+
+					fmt.Sprintf("Error: %s", err),
+			)
+		}
+		return t, diags
+	}
+
+	expr, err := cronplan.Parse(m.Schedule.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Schedule parsing error",
+			"Cannot parse the schedule.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return time.Time{}, diags
+	}
+	loc, err := loadScheduleLocation(m.Location)
+	if err != nil {
+		diags.AddError(
+			"Location loading error",
+			"Cannot load the location.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return time.Time{}, diags
+	}
+	// Fire times are in minutes, and the one in the current minute is not
+	// after now, so the search starts from the next minute.
+	next := expr.Next(now.In(loc).Add(time.Minute).Truncate(time.Minute))
+	if next.IsZero() {
+		diags.AddError(
+			"Schedule never fires",
+			fmt.Sprintf("The schedule never fires after %s: %s", now.Format(time.RFC3339), expr.String()),
+		)
+	}
+	return next, diags
+}
+
+// wait waits until the target time of m and sets target_time. The pinned
+// clock is used only for dry run, since the timer runs in the real time.
+func (r *waitResource) wait(ctx context.Context, m *waitResourceModel) (diags diag.Diagnostics) {
+	var clk clock = systemClock{}
+	if m.DryRun.ValueBool() {
+		clk = r.clock
+	}
+	now := clk.Now()
+	target, diags := m.targetTime(now)
+	if diags.HasError() {
+		return diags
+	}
+	maxWait, err := time.ParseDuration(m.MaxWait.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Max wait parsing error",
+			"Cannot parse the max_wait.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+		return diags
+	}
+	d := max(target.Sub(now), 0)
+	if d > maxWait {
+		diags.AddError(
+			"Wait too long",
+			fmt.Sprintf("The target time %s is %s later than now, exceeds max_wait %s.", target.Format(time.RFC3339), d, maxWait),
+		)
+		return diags
+	}
+
+	m.TargetTime = types.StringValue(target.Format(time.RFC3339))
+	if m.DryRun.ValueBool() || d == 0 {
+		return diags
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		diags.AddError(
+			"Wait interrupted",
+			fmt.Sprintf("Interrupted while waiting until %s.\n\nError: %s", target.Format(time.RFC3339), ctx.Err()),
+		)
+	}
+	return diags
+}
+
+func (r *waitResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan waitResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(r.clock.Now().UTC().Format(time.RFC3339))
+	res.Diagnostics.Append(r.wait(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	diags = res.State.Set(ctx, plan)
+	res.Diagnostics.Append(diags...)
+}
+
+func (r *waitResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	// Nothing to refresh.
+}
+
+func (r *waitResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan waitResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(r.wait(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	diags = res.State.Set(ctx, plan)
+	res.Diagnostics.Append(diags...)
+}
+
+func (r *waitResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+}
+
+type waitResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Until      types.String `tfsdk:"until"`
+	Schedule   types.String `tfsdk:"schedule"`
+	Location   types.String `tfsdk:"location"`
+	MaxWait    types.String `tfsdk:"max_wait"`
+	DryRun     types.Bool   `tfsdk:"dry_run"`
+	TargetTime types.String `tfsdk:"target_time"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+}
@@ -0,0 +1,112 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestWaitResource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01T00:30:00Z"
+				}
+				resource "timeconv_wait" "schedule" {
+					schedule = "0 10 ? * MON#1 *"
+					location = "Asia/Tokyo"
+					dry_run  = true
+				}
+				resource "timeconv_wait" "current_minute" {
+					schedule = "30 0 * * ? *"
+					max_wait = "25h"
+					dry_run  = true
+				}
+				resource "timeconv_wait" "past" {
+					until = "2024-07-01T00:00:00Z"
+				}
+				resource "timeconv_wait" "pinned_future" {
+					until = "2024-07-01T00:40:00Z"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeconv_wait.schedule", "target_time", "2024-07-01T10:00:00+09:00"),
+					resource.TestCheckResourceAttr("timeconv_wait.schedule", "max_wait", "1h"),
+					// The fire time in the current minute is not after now.
+					resource.TestCheckResourceAttr("timeconv_wait.current_minute", "target_time", "2024-07-02T00:30:00Z"),
+					resource.TestCheckResourceAttr("timeconv_wait.past", "target_time", "2024-07-01T00:00:00Z"),
+					// Waiting is measured by the system clock, so it is over.
+					resource.TestCheckResourceAttr("timeconv_wait.pinned_future", "target_time", "2024-07-01T00:40:00Z"),
+				),
+			},
+			{
+				Config: `
+				provider "timeconv" {
+					now = "2024-07-01T00:30:00Z"
+				}
+				resource "timeconv_wait" "schedule" {
+					schedule = "0 10 ? * MON#1 *"
+					location = "Asia/Tokyo"
+					max_wait = "10m"
+					dry_run  = true
+				}
+				`,
+				ExpectError: regexp.MustCompile(`exceeds\s+max_wait\s+10m0s`),
+			},
+		},
+	})
+}
+
+func TestWaitResourceInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "timeconv_wait" "both" {
+					until    = "2024-07-01T00:00:00Z"
+					schedule = "0 10 * * ? *"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly\s+one\s+of\s+these\s+attributes\s+must\s+be\s+configured:\s+\[until,schedule\]`),
+			},
+			{
+				Config: `
+				resource "timeconv_wait" "neither" {
+					location = "Asia/Tokyo"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly\s+one\s+of\s+these\s+attributes\s+must\s+be\s+configured`),
+			},
+			{
+				Config: `
+				resource "timeconv_wait" "max_wait" {
+					until    = "2024-07-01T00:00:00Z"
+					max_wait = "1 hour"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Cannot\s+parse\s+the\s+max_wait`),
+			},
+			{
+				Config: `
+				resource "timeconv_wait" "negative_max_wait" {
+					until    = "2024-07-01T00:00:00Z"
+					max_wait = "-1h"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`max_wait\s+must\s+not\s+be\s+negative`),
+			},
+		},
+	})
+}
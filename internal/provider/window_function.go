@@ -0,0 +1,201 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/winebarrel/cronplan"
+)
+
+var windowAttrTypes = map[string]attr.Type{
+	"start": types.StringType,
+	"end":   types.StringType,
+}
+
+// lastCronStart returns the last fire time of expr at or before t within
+// limit, or the zero time. The lookback doubles from an hour, so it does not
+// enumerate all the fire times in limit.
+func lastCronStart(expr *cronplan.Expression, t time.Time, limit time.Duration) time.Time {
+	for span := min(time.Hour, limit); ; span = min(span*2, limit) {
+		starts := expr.Between(t.Add(-span), t.Add(time.Second))
+		for i := len(starts) - 1; i >= 0; i-- {
+			if !starts[i].After(t) {
+				return starts[i]
+			}
+		}
+		if span == limit {
+			return time.Time{}
+		}
+	}
+}
+
+// currentRecurringWindow returns the occurrence of the window starting on expr
+// in loc for d which contains t, or the zero times. Starts are in wall clock
+// time of loc, and d is elapsed time, so windows across the change of UTC
+// offset last for d. As all the windows last for d, only the last start at or
+// before t can contain t.
+func currentRecurringWindow(expr *cronplan.Expression, d time.Duration, t time.Time, loc *time.Location) (time.Time, time.Time) {
+	t = t.In(loc)
+	if start := lastCronStart(expr, t, d); !start.IsZero() && t.Before(start.Add(d)) {
+		return start, start.Add(d)
+	}
+	return time.Time{}, time.Time{}
+}
+
+// nextRecurringWindow returns the occurrence of the window which contains t,
+// or the next one. The zero times are returned if there is none.
+func nextRecurringWindow(expr *cronplan.Expression, d time.Duration, t time.Time, loc *time.Location) (time.Time, time.Time) {
+	if start, end := currentRecurringWindow(expr, d, t, loc); !start.IsZero() {
+		return start, end
+	}
+	start := expr.Next(t.In(loc))
+	if start.IsZero() {
+		return start, start
+	}
+	return start, start.Add(d)
+}
+
+// parseRecurringWindow parses the arguments of the recurring window functions.
+func parseRecurringWindow(ctx context.Context, req function.RunRequest) (time.Time, *cronplan.Expression, time.Duration, *time.Location, *function.FuncError) {
+	var input timetypes.RFC3339
+	var cronStart, duration string
+	var location types.String
+
+	if err := req.Arguments.Get(ctx, &input, &cronStart, &duration, &location); err != nil {
+		return time.Time{}, nil, 0, nil, err
+	}
+
+	t, _ := input.ValueRFC3339Time()
+	expr, err := cronplan.Parse(cronStart)
+	if err != nil {
+		return t, nil, 0, nil, function.NewArgumentFuncError(1, err.Error())
+	}
+	d, err := parseDuration(duration)
+	if err == nil && d <= 0 {
+		err = fmt.Errorf("duration must be positive: %q", duration)
+	}
+	if err != nil {
+		return t, nil, 0, nil, function.NewArgumentFuncError(2, err.Error())
+	}
+	loc, err := loadScheduleLocation(location)
+	if err != nil {
+		return t, nil, 0, nil, function.NewArgumentFuncError(3, err.Error())
+	}
+	return t, expr, d, loc, nil
+}
+
+// recurringWindowParameters are the parameters of the recurring window
+// functions.
+var recurringWindowParameters = []function.Parameter{
+	function.StringParameter{
+		Name:           "input",
+		Description:    "Input time string in RFC3339 format",
+		CustomType:     timetypes.RFC3339Type{},
+		AllowNullValue: false,
+	},
+	function.StringParameter{
+		Name:           "cron_start",
+		Description:    "Start of the window in Amazon EventBridge cron format (w/o \"cron(...)\"). See: https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions",
+		AllowNullValue: false,
+	},
+	function.StringParameter{
+		Name:           "duration",
+		Description:    "Duration of the window(golang time package style plus `d` for days). like `6h`, `2d`",
+		AllowNullValue: false,
+	},
+	function.StringParameter{
+		Name:           "location",
+		Description:    "IANA timezone location. like `UTC`, `America/New_York`, `Asia/Tokyo`",
+		AllowNullValue: true,
+	},
+}
+
+type inWindow struct{}
+
+// Definition implements function.Function.
+func (w *inWindow) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether time is inside recurring window",
+		Description: "Return true if input is inside any occurrence of the window which starts on cron_start in location and lasts for duration. The window includes its start and excludes its end. If location is null, UTC is used.",
+
+		Parameters: recurringWindowParameters,
+		Return:     function.BoolReturn{},
+	}
+}
+
+// Metadata implements function.Function.
+func (w *inWindow) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "in_window"
+}
+
+// Run implements function.Function.
+func (w *inWindow) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	t, expr, d, loc, err := parseRecurringWindow(ctx, req)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+	start, _ := currentRecurringWindow(expr, d, t, loc)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, !start.IsZero()))
+}
+
+var _ function.Function = (*inWindow)(nil)
+
+func NewInWindowFunction() function.Function {
+	return &inWindow{}
+}
+
+type nextWindow struct{}
+
+// Definition implements function.Function.
+func (w *nextWindow) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return current or next occurrence of recurring window",
+		Description: "Return `start` and `end` in RFC3339 in location of the occurrence of the window which starts on cron_start in location and lasts for duration, containing input or starting next after input. It is an error if there is no such occurrence. If location is null, UTC is used.",
+
+		Parameters: recurringWindowParameters,
+		Return: function.ObjectReturn{
+			AttributeTypes: windowAttrTypes,
+		},
+	}
+}
+
+// Metadata implements function.Function.
+func (w *nextWindow) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_window"
+}
+
+// Run implements function.Function.
+func (w *nextWindow) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	t, expr, d, loc, err := parseRecurringWindow(ctx, req)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+	start, end := nextRecurringWindow(expr, d, t, loc)
+	if start.IsZero() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("never fires after %s: %s", t.Format(time.RFC3339), expr.String())))
+		return
+	}
+
+	output, diags := types.ObjectValue(windowAttrTypes, map[string]attr.Value{
+		"start": types.StringValue(start.Format(time.RFC3339)),
+		"end":   types.StringValue(end.Format(time.RFC3339)),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, output))
+}
+
+var _ function.Function = (*nextWindow)(nil)
+
+func NewNextWindowFunction() function.Function {
+	return &nextWindow{}
+}
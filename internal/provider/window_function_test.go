@@ -0,0 +1,102 @@
+// Copyright Tatsuya BIZENN <bizenn@gmail.com> 2024, 0
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestInWindowFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "inside" {
+					value = provider::timeconv::in_window("2024-07-06T21:00:00Z", "0 22 ? * SAT *", "6h", "Europe/Berlin")
+				}
+				output "before" {
+					value = provider::timeconv::in_window("2024-07-06T19:59:59Z", "0 22 ? * SAT *", "6h", "Europe/Berlin")
+				}
+				output "end" {
+					value = provider::timeconv::in_window("2024-07-07T02:00:00Z", "0 22 ? * SAT *", "6h", "Europe/Berlin")
+				}
+				output "long_duration" {
+					value = provider::timeconv::in_window("2024-07-07T02:00:30Z", "* * * * ? *", "3650d", null)
+				}
+				output "long_duration_sparse" {
+					value = provider::timeconv::in_window("2024-07-07T02:00:00Z", "0 0 1 1 ? 2020", "3650d", null)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("inside", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("before", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("end", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("long_duration", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("long_duration_sparse", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: `
+				output "invalid_duration" {
+					value = provider::timeconv::in_window("2024-07-06T21:00:00Z", "0 22 ? * SAT *", "0h", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`duration\s+must\s+be\s+positive`),
+			},
+		},
+	})
+}
+
+func TestNextWindowFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "current" {
+					value = provider::timeconv::next_window("2024-07-06T21:00:00Z", "0 22 ? * SAT *", "6h", "Europe/Berlin")
+				}
+				output "next" {
+					value = provider::timeconv::next_window("2024-07-08T00:00:00Z", "0 22 ? * SAT *", "6h", "Europe/Berlin")
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("current", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"start": knownvalue.StringExact("2024-07-06T22:00:00+02:00"),
+						"end":   knownvalue.StringExact("2024-07-07T04:00:00+02:00"),
+					})),
+					statecheck.ExpectKnownOutputValue("next", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"start": knownvalue.StringExact("2024-07-13T22:00:00+02:00"),
+						"end":   knownvalue.StringExact("2024-07-14T04:00:00+02:00"),
+					})),
+				},
+			},
+			{
+				Config: `
+				output "never_fires" {
+					value = provider::timeconv::next_window("2024-07-08T00:00:00Z", "0 22 ? * SAT 2020", "6h", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`never\s+fires\s+after`),
+			},
+		},
+	})
+}